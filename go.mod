@@ -3,3 +3,5 @@ module github.com/seambiz/varcaser
 go 1.23.0
 
 require golang.org/x/text v0.17.0
+
+require gopkg.in/yaml.v3 v3.0.1
@@ -0,0 +1,94 @@
+package varcaser
+
+// This file defines the splitting of variable names around AtomicTokens.
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// atomicWords is words for a Caser with AtomicTokens: every token found in s
+// is a word of its own, and the text around the tokens is split as usual,
+// without the separators next to the tokens.
+func (c Caser) atomicWords(s string) []string {
+	words := []string{}
+	split := func(text string) {
+		if strings.IndexFunc(text, isWordRune) >= 0 {
+			words = append(words, c.splitWords(text)...)
+		}
+	}
+
+	start := 0 // start of the text after the last token
+	for i := 0; i < len(s); {
+		if token, n, ok := c.matchToken(s, i); ok {
+			text := strings.TrimRightFunc(s[start:i], isNotWordRune)
+			if start > 0 {
+				text = strings.TrimLeftFunc(text, isNotWordRune)
+			}
+			split(text)
+			words = append(words, token)
+			i += n
+			start = i
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+
+	text := s[start:]
+	if start > 0 {
+		text = strings.TrimLeftFunc(text, isNotWordRune)
+	}
+	split(text)
+	return words
+}
+
+// matchToken returns the longest of the AtomicTokens that starts a word at
+// s[i:] and ends one, and the number of bytes of s it matches. Of tokens of
+// the same length, the first in sort order wins. A word starts at the start
+// of s, after a separator, or at an uppercase letter after a lowercase letter
+// or a digit, and ends anywhere but before a lowercase letter.
+func (c Caser) matchToken(s string, i int) (token string, n int, ok bool) {
+	if i > 0 {
+		previous, _ := utf8.DecodeLastRuneInString(s[:i])
+		current, _ := utf8.DecodeRuneInString(s[i:])
+		startsWord := !isWordRune(previous) ||
+			(unicode.IsUpper(current) && (unicode.IsLower(previous) || unicode.IsDigit(previous)))
+		if !startsWord {
+			return "", 0, false
+		}
+	}
+
+	for _, candidate := range c.AtomicTokens {
+		end := i + len(candidate)
+		if candidate == "" || end > len(s) || !strings.EqualFold(s[i:end], candidate) {
+			continue
+		}
+		if next, _ := utf8.DecodeRuneInString(s[end:]); unicode.IsLower(next) {
+			continue
+		}
+		if len(candidate) > len(token) || (len(candidate) == len(token) && candidate < token) {
+			token = candidate
+		}
+	}
+	return token, len(token), token != ""
+}
+
+// atomicToken returns the token of AtomicTokens that word is, ignoring case.
+// Like matchToken, it prefers the first in sort order of tokens that differ
+// only in case.
+func (c Caser) atomicToken(word string) (token string, ok bool) {
+	for _, candidate := range c.AtomicTokens {
+		if strings.EqualFold(word, candidate) && (!ok || candidate < token) {
+			token, ok = candidate, true
+		}
+	}
+	return token, ok
+}
+
+// isNotWordRune reports whether r is a separator rather than a letter or a
+// digit.
+func isNotWordRune(r rune) bool {
+	return !isWordRune(r)
+}
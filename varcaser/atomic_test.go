@@ -0,0 +1,40 @@
+package varcaser
+
+import "testing"
+
+func TestCaserAtomicTokens(t *testing.T) {
+	tokens := []string{"iOS", "GitHub", "OpenAI", "Open"}
+
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase, AtomicTokens: tokens}
+	AssertEqual(c.Words("iOSAppStore"), []string{"iOS", "App", "Store"}, t)
+	AssertEqual(c.String("iOSAppStore"), "iOS_app_store", t)
+	AssertEqual(c.Words("gitHubRepo"), []string{"GitHub", "Repo"}, t)
+	AssertEqual(c.String("gitHubRepo"), "GitHub_repo", t)
+	AssertEqual(c.String("myOpenAIKey"), "my_OpenAI_key", t)
+	AssertEqual(c.String("github"), "GitHub", t)
+
+	// Tokens only match whole words.
+	AssertEqual(c.String("biosSetting"), "bios_setting", t)
+	AssertEqual(c.String("openingHours"), "opening_hours", t)
+
+	toCamel := Caser{From: KebabCase, To: UpperCamelCase, AtomicTokens: tokens}
+	AssertEqual(toCamel.String("my-ios-app"), "MyiOSApp", t)
+	AssertEqual(toCamel.String("github-repo"), "GitHubRepo", t)
+	AssertEqual(toCamel.String("open-ai-key"), "OpenAiKey", t)
+	AssertEqual(toCamel.String("open-door"), "OpenDoor", t)
+
+	// Without tokens, the words come apart.
+	c.AtomicTokens = nil
+	AssertEqual(c.String("gitHubRepo"), "git_hub_repo", t)
+}
+
+func TestCaserAtomicTokensLongestMatch(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase, AtomicTokens: []string{"Git", "GitHub", "gitHUB"}}
+	AssertEqual(c.Words("githubrepo"), []string{"githubrepo"}, t)
+	AssertEqual(c.String("github_repo"), "GitHubRepo", t)
+	AssertEqual(c.String("git_repo"), "GitRepo", t)
+
+	c.AtomicTokens = []string{"gitHUB", "GitHub"}
+	AssertEqual(c.String("github_repo"), "GitHubRepo", t)
+	AssertEqual(Caser{From: LowerCamelCase, To: KebabCase, AtomicTokens: c.AtomicTokens}.String("gitHubRepo"), "GitHub-repo", t)
+}
@@ -0,0 +1,74 @@
+package varcaser
+
+// This file defines conversions of whole sets of variable names.
+
+// DetectCollisions converts each of the names and returns, for every result
+// that more than one distinct name converts to, the names that do, in the
+// order they were given. Results without collisions are left out, so an empty
+// map means the conversion loses no names.
+func (c Caser) DetectCollisions(names []string) map[string][]string {
+	sources := map[string][]string{}
+	seen := map[string]bool{}
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		converted := c.String(name)
+		sources[converted] = append(sources[converted], name)
+	}
+
+	collisions := map[string][]string{}
+	for converted, names := range sources {
+		if len(names) > 1 {
+			collisions[converted] = names
+		}
+	}
+	return collisions
+}
+
+// A RenamePlan is the planned conversion of one variable name.
+type RenamePlan struct {
+	From    string
+	To      string
+	Changed bool
+}
+
+// RenamePlans is the plan for renaming a set of variable names, as returned
+// by Caser.Plan.
+type RenamePlans []RenamePlan
+
+// Plan returns the planned conversion of each of the distinct names, in the
+// order they were first given.
+func (c Caser) Plan(names []string) RenamePlans {
+	plans := RenamePlans{}
+	seen := map[string]bool{}
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		converted := c.String(name)
+		plans = append(plans, RenamePlan{From: name, To: converted, Changed: converted != name})
+	}
+	return plans
+}
+
+// Conflicts returns, for every target name that more than one plan renames a
+// name to, the names that would get it, in the order of the plans. Unchanged
+// names count as claiming their own name. Targets without conflicts are left
+// out, so an empty map means the renames can all be applied.
+func (plans RenamePlans) Conflicts() map[string][]string {
+	sources := map[string][]string{}
+	for _, plan := range plans {
+		sources[plan.To] = append(sources[plan.To], plan.From)
+	}
+
+	conflicts := map[string][]string{}
+	for target, names := range sources {
+		if len(names) > 1 {
+			conflicts[target] = names
+		}
+	}
+	return conflicts
+}
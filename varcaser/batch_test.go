@@ -0,0 +1,42 @@
+package varcaser
+
+import "testing"
+
+func TestDetectCollisions(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+
+	specimen := c.DetectCollisions([]string{"userID", "firstName", "userId", "userID", "user_id"})
+	expected := map[string][]string{
+		"user_id": {"userID", "userId", "user_id"},
+	}
+	AssertEqual(specimen, expected, t)
+}
+
+func TestDetectCollisionsNone(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+
+	AssertEqual(c.DetectCollisions([]string{"userID", "firstName", "userID"}), map[string][]string{}, t)
+	AssertEqual(c.DetectCollisions(nil), map[string][]string{}, t)
+}
+
+func TestCaserPlan(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+
+	plans := c.Plan([]string{"name", "firstName", "userID", "name"})
+	AssertEqual(plans, RenamePlans{
+		{From: "name", To: "name", Changed: false},
+		{From: "firstName", To: "first_name", Changed: true},
+		{From: "userID", To: "user_id", Changed: true},
+	}, t)
+	AssertEqual(plans.Conflicts(), map[string][]string{}, t)
+	AssertEqual(c.Plan(nil).Conflicts(), map[string][]string{}, t)
+}
+
+func TestRenamePlansConflicts(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+
+	plans := c.Plan([]string{"userID", "user_id", "firstName", "userId"})
+	AssertEqual(plans.Conflicts(), map[string][]string{
+		"user_id": {"userID", "user_id", "userId"},
+	}, t)
+}
@@ -0,0 +1,38 @@
+package varcaser
+
+// This file defines the CachingCaser type.
+
+import "sync"
+
+// A CachingCaser converts variable names like a Caser, but remembers every
+// conversion, so that converting the same name again is a map lookup. It is
+// safe for concurrent use.
+//
+// The cache is never evicted on its own: it holds one entry for every
+// distinct name converted since the last call to Reset, so it only pays off
+// for workloads that convert a limited set of names over and over.
+type CachingCaser struct {
+	caser Caser
+	cache sync.Map // map[string]string
+}
+
+// NewCachingCaser returns a CachingCaser that converts names with c.
+func NewCachingCaser(c Caser) *CachingCaser {
+	return &CachingCaser{caser: c}
+}
+
+// String returns the result of the underlying Caser's String for s, from the
+// cache if s has been converted before.
+func (c *CachingCaser) String(s string) string {
+	if result, ok := c.cache.Load(s); ok {
+		return result.(string)
+	}
+	result := c.caser.String(s)
+	c.cache.Store(s, result)
+	return result
+}
+
+// Reset empties the cache.
+func (c *CachingCaser) Reset() {
+	c.cache.Clear()
+}
@@ -0,0 +1,75 @@
+package varcaser
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestCachingCaser(t *testing.T) {
+	c := NewCachingCaser(Caser{From: LowerCamelCase, To: LowerSnakeCase})
+
+	AssertEqual(c.String("userID"), "user_id", t)
+	AssertEqual(c.String("userID"), "user_id", t)
+	c.Reset()
+	AssertEqual(c.String("userID"), "user_id", t)
+}
+
+func TestCachingCaserConcurrent(t *testing.T) {
+	plain := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	c := NewCachingCaser(plain)
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 64)
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				name := fmt.Sprintf("someVariable%dName", (g+i)%50)
+				if specimen, expected := c.String(name), plain.String(name); specimen != expected {
+					errs <- fmt.Sprintf("Wanted %v, got %v", expected, specimen)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+// skewedNames returns n names in which a few names make up most of the
+// occurrences, the way identifiers do in source code.
+func skewedNames(n int) []string {
+	names := make([]string, n)
+	for i := range names {
+		if i%10 < 8 {
+			names[i] = fmt.Sprintf("commonHttpName%d", i%5)
+		} else {
+			names[i] = fmt.Sprintf("rareHttpName%d", i)
+		}
+	}
+	return names
+}
+
+func BenchmarkCaserSkewed(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	names := skewedNames(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.String(names[i%len(names)])
+	}
+}
+
+func BenchmarkCachingCaserSkewed(b *testing.B) {
+	c := NewCachingCaser(Caser{From: LowerCamelCase, To: LowerSnakeCase})
+	names := skewedNames(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.String(names[i%len(names)])
+	}
+}
@@ -5,6 +5,7 @@ package varcaser
 import (
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 type WordCase func(string) string
@@ -16,6 +17,20 @@ type CaseConvention struct {
 	SubsequentCase WordCase
 	InitialCase    WordCase
 	Example        string // Render the name of this case convention in itself
+
+	// KeepInitialisms makes a Caser uppercase every word that is a known
+	// initialism, such as ID or HTTP, wherever it occurs. The exception is
+	// a first word that InitialCase renders in lowercase, so that
+	// lowerCamelCase names start with "id" rather than "ID". Adjacent
+	// initialisms are joined like any other words, so "http_url_parser"
+	// becomes "HTTPURLParser" in UpperCamelCase. Splitting such a name
+	// again can't tell where one initialism ends and the next begins, and
+	// keeps them together as "HTTPURL".
+	KeepInitialisms bool
+
+	// PostSplit, when set, post-processes the components returned by
+	// Split, for example to put domain-specific tokens back together.
+	PostSplit func([]string) []string
 }
 
 // A JoinStyle is a way of representing how individual components of a variable
@@ -23,6 +38,29 @@ type CaseConvention struct {
 type JoinStyle struct {
 	Join  func([]string) string
 	Split func(string) []string
+
+	// plainJoin and plainSplit are set to Join and Split on the JoinStyles
+	// of this package whose Split returns a word of lowercase ASCII letters
+	// as the only component, and whose Join returns a single component
+	// unchanged. Caser.String then converts such words without splitting
+	// and joining them, unless Join or Split has been replaced since.
+	plainJoin  func([]string) string
+	plainSplit func(string) []string
+}
+
+// withPlainWords returns js with the plainJoin and plainSplit of a JoinStyle
+// whose functions leave single words of lowercase ASCII letters intact.
+func withPlainWords(js JoinStyle) JoinStyle {
+	js.plainJoin, js.plainSplit = js.Join, js.Split
+	return js
+}
+
+// plainWords reports whether js is known to leave single words of lowercase
+// ASCII letters intact: it was created so, and its functions haven't been
+// replaced.
+func (js JoinStyle) plainWords() bool {
+	return js.plainJoin != nil && js.plainSplit != nil &&
+		sameFunc(js.Join, js.plainJoin) && sameFunc(js.Split, js.plainSplit)
 }
 
 var commonInitialisms = []string{
@@ -41,10 +79,14 @@ var commonInitialisms = []string{
 	"IP",
 	"JSON",
 	"LHS",
+	"MD5",
 	"QPS",
 	"RAM",
 	"RHS",
 	"RPC",
+	"SHA1",
+	"SHA256",
+	"SHA512",
 	"SLA",
 	"SMTP",
 	"SQL",
@@ -69,7 +111,7 @@ var commonInitialisms = []string{
 // SimpleJoinStyle creates a JoinStyle that just splits and joins by a
 // separator.
 func SimpleJoinStyle(sep string) JoinStyle {
-	return JoinStyle{
+	js := JoinStyle{
 		Join: func(components []string) string {
 			return strings.Join(components, sep)
 		},
@@ -77,99 +119,455 @@ func SimpleJoinStyle(sep string) JoinStyle {
 			return strings.Split(s, sep)
 		},
 	}
+	if sep != "" && !strings.ContainsAny(sep, "abcdefghijklmnopqrstuvwxyz") {
+		js = withPlainWords(js)
+	}
+	return js
+}
+
+// AffixJoinStyle creates a JoinStyle that joins by a separator like
+// SimpleJoinStyle, and wraps the result in prefix and suffix, as in
+// "<foo_bar>" or "@foo-bar@". Splitting strips the prefix and the suffix, if
+// present, before splitting by the separator.
+func AffixJoinStyle(prefix, sep, suffix string) JoinStyle {
+	return JoinStyle{
+		Join: func(components []string) string {
+			return prefix + strings.Join(components, sep) + suffix
+		},
+		Split: func(s string) []string {
+			s = strings.TrimPrefix(s, prefix)
+			s = strings.TrimSuffix(s, suffix)
+			return strings.Split(s, sep)
+		},
+	}
+}
+
+// An initialismSet is a set of uppercase initialisms.
+type initialismSet struct {
+	words map[string]bool
+
+	// longest is the length in bytes of the longest initialism, which
+	// bounds the search of isInitialisms.
+	longest int
+}
+
+// newInitialismSet returns the set of the given initialisms, uppercased.
+func newInitialismSet(initialisms []string) *initialismSet {
+	set := &initialismSet{words: map[string]bool{}}
+	set.add(initialisms)
+	return set
+}
+
+// add adds the given initialisms to set, uppercased.
+func (set *initialismSet) add(initialisms []string) {
+	for _, initialism := range initialisms {
+		if initialism != "" {
+			initialism = strings.ToUpper(initialism)
+			set.words[initialism] = true
+			set.longest = max(set.longest, len(initialism))
+		}
+	}
+}
+
+// with returns a copy of set with the added initialisms and without the
+// removed ones. Both are matched case-insensitively.
+func (set *initialismSet) with(added, removed []string) *initialismSet {
+	removedSet := newInitialismSet(removed)
+	result := &initialismSet{words: map[string]bool{}}
+	for initialism := range set.words {
+		if !removedSet.has(initialism) {
+			result.add([]string{initialism})
+		}
+	}
+	result.add(added)
+	return result
+}
+
+// has reports whether the uppercase word is in set.
+func (set *initialismSet) has(word string) bool {
+	return set.words[word]
+}
+
+// commonInitialismSet is the set of commonInitialisms and the initialisms
+// added by RegisterInitialism, used for lookups.
+var commonInitialismSet = newInitialismSet(commonInitialisms)
+
+// RegisterInitialism adds words to the default initialisms, which are used by
+// every Caser that hasn't been given its own with WithInitialisms. The words
+// are matched case-insensitively.
+//
+// RegisterInitialism is not safe to call concurrently with conversions or with
+// ResetInitialisms. Call it during initialization, such as from an init
+// function.
+func RegisterInitialism(words ...string) {
+	commonInitialismSet = commonInitialismSet.with(words, nil)
+}
+
+// ResetInitialisms removes the initialisms added by RegisterInitialism. Like
+// RegisterInitialism, it is not safe to call concurrently with conversions.
+func ResetInitialisms() {
+	commonInitialismSet = newInitialismSet(commonInitialisms)
+}
+
+// isInitialisms reports whether word consists entirely of initialisms in set,
+// such as "ID" or "HttpUrl". Matching against word is case-insensitive. A word
+// that isn't an initialism itself is read as several abutting ones, trying the
+// longest match first, so "UUIDUID" is UUID followed by UID. The result
+// depends only on the initialisms in set, never on the order a map yields them.
+func isInitialisms(word string, set *initialismSet) bool {
+	if word == "" {
+		return false
+	}
+
+	// Map lookups with string(upper[i:j]) keys don't allocate, and most
+	// words are short enough for these arrays to stay on the stack.
+	var upperBuf [32]byte
+	var reachableBuf [33]bool
+	upper := appendUpper(upperBuf[:0], word)
+	if set.has(string(upper)) {
+		return true
+	}
+
+	// reachable[i] reports whether upper[:i] is a sequence of initialisms.
+	var reachable []bool
+	if len(upper) < len(reachableBuf) {
+		reachable = reachableBuf[:len(upper)+1]
+	} else {
+		reachable = make([]bool, len(upper)+1)
+	}
+	reachable[0] = true
+	for i := 0; i < len(upper); i++ {
+		if !reachable[i] {
+			continue
+		}
+		for end := min(len(upper), i+set.longest); end > i; end-- {
+			if set.has(string(upper[i:end])) {
+				reachable[end] = true
+			}
+		}
+	}
+	return reachable[len(upper)]
+}
+
+// appendUpper appends the uppercase of s to b.
+func appendUpper(b []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return append(b, strings.ToUpper(s)...)
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		b = append(b, c)
+	}
+	return b
+}
+
+// isAcronymSuffixAt reports whether s[start:i] is in all caps and the rune at
+// i is a lowercase "s" or "v" that belongs with it rather than starting the
+// next word, because no lowercase letter follows, as in "userIDs", "JWTs" or
+// "APIv2".
+func isAcronymSuffixAt(s string, start, i int) bool {
+	if !isAcronymSuffix(rune(s[i])) || !isAllCaps(s[start:i]) {
+		return false
+	}
+	next, _ := utf8.DecodeRuneInString(s[i+1:])
+	return !unicode.IsLower(next)
+}
+
+// isAcronymSuffix reports whether r may mark an acronym as a plural or a
+// version, as in "IDs" or "APIv2".
+func isAcronymSuffix(r rune) bool {
+	return r == 's' || r == 'v'
+}
+
+// pluralInitialism returns the initialism that word is the plural of, such as
+// "ID" for "IDs" or "ids", if there is one in set.
+func pluralInitialism(word string, set *initialismSet) (string, bool) {
+	if len(word) < 3 || (word[len(word)-1] != 's' && word[len(word)-1] != 'S') {
+		return "", false
+	}
+	singular := word[:len(word)-1]
+	if !isInitialisms(singular, set) {
+		return "", false
+	}
+	return strings.ToUpper(singular), true
+}
+
+// cutVersion splits a version tag, such as "v2" or "V10", off the end of word,
+// as in "APIv2". The tag is returned as it is in word.
+func cutVersion(word string) (base, version string, ok bool) {
+	i := len(word)
+	for i > 0 && '0' <= word[i-1] && word[i-1] <= '9' {
+		i--
+	}
+	if i == len(word) || i < 2 || (word[i-1] != 'v' && word[i-1] != 'V') {
+		return "", "", false
+	}
+	return word[:i-1], word[i-1:], true
+}
+
+// isLower reports whether s is unchanged by strings.ToLower, without
+// allocating.
+func isLower(s string) bool {
+	for _, r := range s {
+		if unicode.ToLower(r) != r {
+			return false
+		}
+	}
+	return true
 }
 
 // JoinStyle used in CamelCase. Special casing the Split function to keep
-// acronyms together.
-var camelJoinStyle = JoinStyle{
+// acronyms together. Initialisms are handled by the Caser, see
+// CaseConvention.KeepInitialisms.
+var camelJoinStyle = withPlainWords(JoinStyle{
 	Join: func(components []string) string {
-		s := strings.Join(components, "")
-
-		// initialisms
-		{
-			upper := strings.ToUpper(s)
-			// replace intialims at the beginning
-			for _, initialism := range commonInitialisms {
-				if strings.HasPrefix(upper, initialism) {
-					s = strings.Replace(s, s[0:len(initialism)], initialism, 1)
-					break
-				}
-			}
+		return strings.Join(components, "")
+	},
+	Split: SplitCamel,
+})
+
+// SplitCamel splits a camelCase or PascalCase variable name into its words,
+// exactly as UpperCamelCase and LowerCamelCase do, so "getHTTPStatus" becomes
+// ["get", "HTTP", "Status"].
+func SplitCamel(s string) (components []string) {
+	eachCamelWord(s, func(word string) {
+		components = append(components, word)
+	})
+	return components
+}
+
+// eachCamelWord calls yield with each of the words that SplitCamel splits s
+// into, in order.
+func eachCamelWord(s string, yield func(word string)) {
+	// NOTE(danver): While I keep finding new edge cases, I'll want
+	// this to be easy-to-modify code rather than a regex.
+
+	// The current word is s[start:i], and its last rune, previous,
+	// starts at last. Words are sliced from s rather than built up
+	// rune by rune to save allocations.
+	//
+	// Starting as if after an uppercase letter keeps a leading
+	// capital with the word it starts. Leading digits don't change
+	// it, so they stay with the letters that follow them until the
+	// next word starts, as in ["2nd", "Place"] or ["3D", "Model"].
+	wasPreviousUpper := true
+	start, last := 0, 0
+	var previous rune
+	for i, c := range s {
+		switch {
+		case unicode.IsDigit(c):
+			// Digits have no case, so they continue the
+			// current word whatever its case, keeping
+			// "HTML5" and "utf8" together. See DigitStyle
+			// for splitting them off.
+
+		case !unicode.IsLetter(c):
+			// Neither do separators, which only come apart
+			// with LenientSplit. An uppercase word before
+			// one keeps its last letter, as in "FOO_BAR".
+
+		case wasPreviousUpper && !unicode.IsUpper(c):
+			// If the previous run was uppercase, but this
+			// is not, set previous, but add it.
 
-			// replace initialisms at the end
-			for _, initialism := range commonInitialisms {
-				if strings.HasSuffix(upper, initialism) {
-					index := strings.LastIndex(upper, initialism)
-
-					buf := strings.Builder{}
-					buf.Grow(len(s))
-					buf.WriteString(s[0:index])
-					buf.WriteString(initialism)
-					s = buf.String()
-					break
-				}
+			// Edge case: the previous word was all uppercase.
+			// Its last letter starts this word, unless the
+			// word ended in a digit, or it is an acronym in
+			// the plural or with a version, like "IDs" or
+			// "APIv2".
+			if last > start && unicode.IsUpper(previous) && !isAcronymSuffixAt(s, start, i) {
+				yield(s[start:last])
+				start = last
 			}
+			wasPreviousUpper = false
+
+		case !wasPreviousUpper && unicode.IsUpper(c):
+			// If the previous rune was not uppercase, and
+			// this character is, yield current first, then
+			// set wasPreviousUpper
+
+			yield(s[start:i])
+			start = i
+			wasPreviousUpper = true
 		}
 
-		return s
+		// In every other case, the case did not change, and c
+		// just continues the current word.
+		last, previous = i, c
+	}
+	if start < len(s) {
+		yield(s[start:])
+	}
+}
+
+// SplitSnake splits a snake_case variable name into its words, exactly as
+// LowerSnakeCase and ScreamingSnakeCase do.
+func SplitSnake(s string) []string {
+	return LowerSnakeCase.Split(s)
+}
+
+// SplitKebab splits a kebab-case variable name into its words, exactly as
+// KebabCase and the other hyphenated CaseConventions do.
+func SplitKebab(s string) []string {
+	return KebabCase.Split(s)
+}
+
+// JoinStyle used in flatcase. Words are concatenated without a separator, so
+// splitting can only rely on whatever case changes survive in the input. For
+// input that is entirely lower- or uppercase, the word boundaries are lost and
+// the whole string comes back as one component.
+var flatJoinStyle = withPlainWords(JoinStyle{
+	Join: func(components []string) string {
+		return strings.Join(components, "")
 	},
-	Split: func(s string) (components []string) {
-		// NOTE(danver): While I keep finding new edge cases, I'll want
-		// this to be easy-to-modify code rather than a regex.
-
-		wasPreviousUpper := true
-		current := []rune{}
-		for _, c := range s {
-			if wasPreviousUpper && unicode.IsUpper(c) {
-				// If previous was uppercase, and this is
-				// uppercase, continue the word.
-
-				current = append(current, c)
-			} else if wasPreviousUpper && !unicode.IsUpper(c) {
-
-				// If the previous run was uppercase, but this
-				// is not, set previous, but add it.
-
-				// Edge case: the previous word was all uppercase.
-				if len(current) > 1 {
-					components = append(components, string(current[:len(current)-1]))
-					current = current[len(current)-1:]
-				}
-
-				current = append(current, c)
-				wasPreviousUpper = false
-			} else if !wasPreviousUpper && unicode.IsUpper(c) {
-
-				// If the previous rune was not uppercase, and
-				// this character is, put current into
-				// components first, then set wasPreviousUpper
-
-				components = append(components, string(current))
-				current = []rune{c}
-				wasPreviousUpper = true
-			} else if !wasPreviousUpper && !unicode.IsUpper(c) {
-				// If the previous rune was not uppercase, and
-				// this one is not, just add to this component.
-
-				current = append(current, c)
-			}
+	Split: camelJoinStyle.Split,
+})
+
+// JoinStyle used for human-readable labels. Words are joined with single
+// spaces, and runs of whitespace split words without leaving empty ones
+// behind.
+var spaceJoinStyle = withPlainWords(JoinStyle{
+	Join: func(components []string) string {
+		return strings.Join(components, " ")
+	},
+	Split: strings.Fields,
+})
+
+// JoinStyle used for paths. Unlike SimpleJoinStyle("/"), splitting drops the
+// empty components left by doubled, leading or trailing slashes.
+var slashJoinStyle = withPlainWords(JoinStyle{
+	Join: func(components []string) string {
+		return strings.Join(components, "/")
+	},
+	Split: func(s string) []string {
+		return strings.FieldsFunc(s, func(r rune) bool {
+			return r == '/'
+		})
+	},
+})
+
+// JoinStyle used in snake_case. It is SimpleJoinStyle("_"), but with a Join
+// function of its own, by which a Caser recognizes the conventions whose
+// conversions it specializes.
+var snakeJoinStyle = withPlainWords(JoinStyle{
+	Join: joinSnake,
+	Split: func(s string) []string {
+		return strings.Split(s, "_")
+	},
+})
+
+// joinSnake is the Join function of snakeJoinStyle.
+func joinSnake(components []string) string {
+	return strings.Join(components, "_")
+}
+
+// lenientSplit splits each of the words on hyphens, underscores, whitespace
+// and camel case boundaries, dropping empty words.
+func lenientSplit(words []string) []string {
+	components := []string{}
+	for _, word := range words {
+		for _, field := range strings.FieldsFunc(word, isLenientSeparator) {
+			components = append(components, camelJoinStyle.Split(field)...)
 		}
-		if len(current) != 0 {
-			components = append(components, string(current))
+	}
+	return components
+}
+
+// isLenientSeparator reports whether lenientSplit splits on r.
+func isLenientSeparator(r rune) bool {
+	return r == '-' || r == '_' || unicode.IsSpace(r)
+}
+
+// CustomSplitJoinStyle creates a JoinStyle from the given functions. It is
+// equivalent to the JoinStyle literal, for when the split needs logic that
+// none of the predefined JoinStyles have.
+func CustomSplitJoinStyle(join func([]string) string, split func(string) []string) JoinStyle {
+	return JoinStyle{Join: join, Split: split}
+}
+
+// WithWordCase returns a copy of c that renders the first word with initial
+// and the other words with subsequent. Everything else, including the
+// JoinStyle, Example and KeepInitialisms, is kept.
+func (c CaseConvention) WithWordCase(initial, subsequent WordCase) CaseConvention {
+	c.InitialCase = initial
+	c.SubsequentCase = subsequent
+	return c
+}
+
+// WithExample returns a copy of c with the given Example, for conventions that
+// are another under a different name. Everything else is kept.
+func (c CaseConvention) WithExample(example string) CaseConvention {
+	c.Example = example
+	return c
+}
+
+// Name returns a short name for c derived from its Example, made of the
+// words of the Example in lowercase snake_case without the word "case", so
+// that LowerCamelCase is "lower_camel", KebabCase is "kebab" and
+// UpperCamelCaseKeepCaps is "upper_camel_keep_caps". It returns "" if c has
+// no Example.
+func (c CaseConvention) Name() string {
+	words := []string{}
+	for _, word := range lenientSplit(strings.FieldsFunc(c.Example, func(r rune) bool {
+		return !isWordRune(r)
+	})) {
+		if word = strings.ToLower(word); word != "case" {
+			words = append(words, word)
 		}
-		return
-	},
+	}
+	if n := len(words); n > 0 {
+		if trimmed := strings.TrimSuffix(words[n-1], "case"); trimmed != "" {
+			words[n-1] = trimmed
+		}
+	}
+	return strings.Join(words, "_")
 }
 
-// SplitWords allows CaseConvention to implement Splitter.
+// SplitWords allows CaseConvention to implement Splitter. The components
+// returned by Split are passed through PostSplit, if set.
 func (c CaseConvention) SplitWords(s string) []string {
-	return c.Split(s)
+	components := c.Split(s)
+	if c.PostSplit != nil {
+		components = c.PostSplit(components)
+	}
+	return components
+}
+
+// ToTitleFirst returns s with its first letter in title case and the rest
+// unchanged. Unlike strings.Title, it doesn't look for word boundaries inside
+// s, which would title letters after apostrophes or other punctuation, and it
+// titles digraphs such as "ǆ" as "ǅ" rather than uppercasing them.
+func ToTitleFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 || unicode.ToTitle(r) == r {
+		return s
+	}
+	return string(unicode.ToTitle(r)) + s[size:]
 }
 
 // ToStrictTitle returns the strict titling of a string without preserving
 // existing caps in acronyms.
 func ToStrictTitle(s string) string {
-	return strings.Title(strings.ToLower(s))
+	return ToTitleFirst(strings.ToLower(s))
+}
+
+// maxSmartTitleAcronym is the longest all-caps word that ToSmartTitle takes
+// for an acronym.
+const maxSmartTitleAcronym = 5
+
+// ToSmartTitle returns the titling of a string like ToStrictTitle, except
+// that words of up to five letters that are entirely uppercase, such as
+// "HTML", are taken for acronyms and kept as they are.
+func ToSmartTitle(s string) string {
+	if n := utf8.RuneCountInString(s); n >= 2 && n <= maxSmartTitleAcronym && isAllCaps(s) {
+		return s
+	}
+	return ToStrictTitle(s)
 }
 
 // HttpAcronyms is effectively a set of acronyms that are conventionally
@@ -188,11 +586,47 @@ var HttpAcronyms = map[string]bool{
 	"UA":   true,
 }
 
-// ToHttpTitle returns a string titled the way HTTP Headers title it.
+// ToHttpTitle returns a string titled the way HTTP Headers title it. Each
+// hyphen-separated part of s is titled on its own, so a whole header name such
+// as "content-md5" becomes "Content-MD5". Parts that are in HttpAcronyms are
+// uppercased rather than titled, even where they are also ordinary words.
 func ToHttpTitle(s string) string {
+	if strings.Contains(s, "-") {
+		parts := strings.Split(s, "-")
+		for i, part := range parts {
+			parts[i] = ToHttpTitle(part)
+		}
+		return strings.Join(parts, "-")
+	}
+
 	upper := strings.ToUpper(s)
 	if _, ok := HttpAcronyms[upper]; ok {
 		return upper
 	}
 	return ToStrictTitle(s)
 }
+
+// PowerShellAcronyms is the set of acronyms that are conventionally
+// uppercased in PowerShell cmdlet parameters and registry keys, as in
+// "DNSServer" or "IPAddress". Other acronyms are titled, as in "ProcessId".
+var PowerShellAcronyms = map[string]bool{
+	"DHCP": true,
+	"DNS":  true,
+	"GUID": true,
+	"IP":   true,
+	"TCP":  true,
+	"UDP":  true,
+	"UNC":  true,
+	"URI":  true,
+}
+
+// ToPowerShellTitle returns a string titled the way PowerShell titles the
+// words of names: words in PowerShellAcronyms are uppercased, and everything
+// else is titled like ToStrictTitle does.
+func ToPowerShellTitle(s string) string {
+	upper := strings.ToUpper(s)
+	if PowerShellAcronyms[upper] {
+		return upper
+	}
+	return ToStrictTitle(s)
+}
@@ -1,7 +1,16 @@
 package varcaser
 
 import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+	"unsafe"
+
 	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
 // type Caser is a text transformer that takes converts a variable from one
@@ -10,6 +19,193 @@ type Caser struct {
 	From Splitter
 	To   CaseConvention
 	transform.NopResetter
+
+	// Digits determines whether runs of digits are split into words of
+	// their own. The default keeps them in the word they were found in.
+	Digits DigitStyle
+
+	// NumbersAreSeparators is a shorthand for Digits: DigitsSeparate. It
+	// makes every run of digits a word of its own, so "address1line2"
+	// becomes ["address", "1", "line", "2"], except in initialisms such as
+	// UTF8.
+	NumbersAreSeparators bool
+
+	// EmptyComponents determines what happens to the empty words left by
+	// doubled separators, as in "foo__bar". The default drops them.
+	EmptyComponents EmptyComponentStyle
+
+	// CaseRules, when set, replaces the default Unicode case mappings used
+	// by the To CaseConvention, for example with unicode.TurkishCase.
+	CaseRules unicode.SpecialCase
+
+	// PreserveLeadingSeparator keeps a run of separators at the start of a
+	// variable name, such as the underscore in "_private", as it is rather
+	// than converting it. PreserveTrailingSeparator does the same at the
+	// end. Any rune that is not a letter or a digit counts as a separator.
+	PreserveLeadingSeparator  bool
+	PreserveTrailingSeparator bool
+
+	// PreserveUnknownAllCaps treats every word of two or more letters that
+	// is all caps in the input as an initialism, even if it isn't a known
+	// one, as long as the To CaseConvention keeps initialisms. Names that
+	// are all caps throughout, such as "GPU_COUNT", carry no such hints
+	// and are converted as usual.
+	PreserveUnknownAllCaps bool
+
+	// MinAcronymLength, when positive, stops words with fewer letters from
+	// being treated as initialisms, so that with 3, "userId" stays as it
+	// is while "userUrl" becomes "userURL". Words from WithAcronymMap are
+	// not affected.
+	MinAcronymLength int
+
+	// PreserveSourceCaps keeps the capitals that words which are not
+	// initialisms have after their first letter in the input, such as
+	// those of "OAuth", "IPv4" or "GRPC", as long as the To CaseConvention
+	// keeps initialisms. The first letter is still cased by the To
+	// CaseConvention, so "GRPC" starts a LowerCamelCase name as "gRPC".
+	// Like PreserveUnknownAllCaps, it ignores names in all caps throughout.
+	PreserveSourceCaps bool
+
+	// WordMap, when set, is called with each word of a variable name and
+	// its index among them, and its result is rendered in the To
+	// CaseConvention instead of the word, for example to singularize or
+	// abbreviate words. Returning "" drops the word.
+	WordMap func(i int, word string) string
+
+	// LowercaseShortWords lists words, such as "a", "of" or "the", that stay
+	// in lowercase when the To CaseConvention capitalizes words and keeps
+	// them apart, as TitleSpaceCase does, so that "numberOfItems" becomes
+	// "Number of Items". The first word is capitalized as usual. Words
+	// match regardless of case.
+	LowercaseShortWords []string
+
+	// LenientSplit further splits the words found by From on hyphens,
+	// underscores, whitespace and camel case boundaries, so that names
+	// mixing separators, such as "my-var_name value", still come apart into
+	// their words. Empty words are dropped.
+	LenientSplit bool
+
+	// CaseOnlySplit splits variable names on case changes alone, the way
+	// LowerCamelCase does, instead of with From, so that separators such as
+	// '_' or '-' stay inside the words they appear in: "foo_barBaz" has the
+	// words "foo_bar" and "Baz". It is the opposite of LenientSplit, which
+	// it overrides.
+	CaseOnlySplit bool
+
+	// NormalizeUnicode puts variable names in Unicode Normalization Form C
+	// before splitting them, so that names that spell a letter such as "é"
+	// with a combining accent convert the same as names that use the
+	// precomposed letter. It is off by default, since normalizing costs
+	// time on every name that is not ASCII.
+	NormalizeUnicode bool
+
+	// AtomicTokens lists brand names and other words, such as "OpenAI",
+	// "GitHub" or "iOS", that are never split or recased. Wherever one of
+	// them makes up whole words of a variable name, matched regardless of
+	// case, it becomes a single word rendered exactly as listed, whatever
+	// the To CaseConvention, so "gitHubRepo" becomes "GitHub_repo" in
+	// LowerSnakeCase. The longest token wins where several match.
+	AtomicTokens []string
+
+	// initialisms overrides commonInitialismSet when non-nil.
+	initialisms *initialismSet
+
+	// acronyms maps lowercase words to the canonical forms set by
+	// WithAcronymMap.
+	acronyms map[string]string
+}
+
+// ErrIncompleteConvention is returned when a CaseConvention is missing one of
+// the functions that a conversion needs, as the zero CaseConvention is.
+var ErrIncompleteConvention = fmt.Errorf("Incomplete case convention.")
+
+// NewCaser returns a Caser converting from one CaseConvention to another, or
+// ErrIncompleteConvention if either of them has a nil function that String
+// would call.
+func NewCaser(from, to CaseConvention) (Caser, error) {
+	if from.Split == nil || to.Join == nil || to.InitialCase == nil || to.SubsequentCase == nil {
+		return Caser{}, ErrIncompleteConvention
+	}
+	return Caser{From: from, To: to}, nil
+}
+
+// MustCaser is like NewCaser, but panics if a convention is incomplete. It is
+// meant for initializing package-level variables.
+func MustCaser(from, to CaseConvention) Caser {
+	c, err := NewCaser(from, to)
+	if err != nil {
+		panic(fmt.Sprintf("varcaser: cannot convert from %q to %q: %v", from.Example, to.Example, err))
+	}
+	return c
+}
+
+// ErrLossyConvention is returned by Validate when the To CaseConvention loses
+// the boundaries between words, so that its results can't be converted back.
+var ErrLossyConvention = fmt.Errorf("Lossy case convention.")
+
+// Validate returns ErrIncompleteConvention if this Caser can't convert at all,
+// and an error wrapping ErrLossyConvention if its To CaseConvention joins words
+// without separators and renders them all in the same case, as FlatCase does,
+// so that the boundaries between the words are lost. Otherwise it returns nil.
+func (c Caser) Validate() error {
+	if c.From == nil || c.To.Join == nil || c.To.InitialCase == nil || c.To.SubsequentCase == nil {
+		return ErrIncompleteConvention
+	}
+
+	const probe = "Word"
+	joined := c.To.Join([]string{c.To.InitialCase(probe), c.To.SubsequentCase(probe)})
+	if strings.IndexFunc(joined, func(r rune) bool { return !isWordRune(r) }) < 0 &&
+		(joined == strings.ToLower(joined) || joined == strings.ToUpper(joined)) {
+		return fmt.Errorf("%w %q joins words without separators or case changes, so converting back can't find them", ErrLossyConvention, c.To.Example)
+	}
+	return nil
+}
+
+// WithInitialisms returns a copy of c that uses the given initialisms instead
+// of the default list when the To CaseConvention keeps initialisms. The
+// initialisms are matched case-insensitively.
+func (c Caser) WithInitialisms(initialisms []string) Caser {
+	c.initialisms = newInitialismSet(initialisms)
+	return c
+}
+
+// WithoutInitialisms returns a copy of c that no longer treats the given
+// words as initialisms. The words are matched case-insensitively, and words
+// that are not initialisms are ignored.
+func (c Caser) WithoutInitialisms(words ...string) Caser {
+	c.initialisms = c.effectiveInitialisms().with(nil, words)
+	return c
+}
+
+// WithAcronymMap returns a copy of c that renders the words in acronyms in
+// their canonical forms wherever the To CaseConvention keeps initialisms, so
+// that with {"id": "ID", "ios": "iOS"}, "user_id" becomes "userID". The keys
+// are matched case-insensitively, and take precedence over the initialisms.
+// Of several keys that differ only in case, the first in sort order wins, so
+// "API" wins over "api". The map is copied.
+func (c Caser) WithAcronymMap(acronyms map[string]string) Caser {
+	words := make([]string, 0, len(acronyms))
+	for word := range acronyms {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+
+	c.acronyms = make(map[string]string, len(acronyms))
+	for _, word := range words {
+		key := strings.ToLower(word)
+		if _, ok := c.acronyms[key]; !ok {
+			c.acronyms[key] = acronyms[word]
+		}
+	}
+	return c
+}
+
+// effectiveInitialisms returns the initialisms used by this Caser.
+func (c Caser) effectiveInitialisms() *initialismSet {
+	if c.initialisms != nil {
+		return c.initialisms
+	}
+	return commonInitialismSet
 }
 
 // Splitter is an interface for a type that can decompose a variable name into
@@ -18,24 +214,527 @@ type Splitter interface {
 	SplitWords(string) []string
 }
 
+// Describe returns a description of the conversion c makes for logs and test
+// failures, such as "Caser(lower_camel → kebab)", using the Names of its
+// CaseConventions. A From Splitter that is not a CaseConvention, or a
+// CaseConvention without an Example, is described as "custom". Describe is
+// not called String because String converts variable names.
+func (c Caser) Describe() string {
+	from := "custom"
+	if convention, ok := c.From.(CaseConvention); ok && convention.Name() != "" {
+		from = convention.Name()
+	}
+	to := "custom"
+	if c.To.Name() != "" {
+		to = c.To.Name()
+	}
+	return fmt.Sprintf("Caser(%s → %s)", from, to)
+}
+
 // String returns the representation of a variable name in this Caser's To
 // CaseConvention given a variable name in this Caser's From CaseConvention.
+// A name without letters or digits, such as "" or "  ", is converted to an
+// empty string, apart from the separators that this Caser preserves.
 func (c Caser) String(s string) string {
-	components := []string{}
-	for i, s := range c.From.SplitWords(s) {
+	result, _ := c.convert(s, nil)
+	return result
+}
+
+// convert is String, but renders the words of s into scratch, reusing its
+// memory if it is large enough. It returns the converted name and the slice
+// of rendered words, or scratch if there were none, for PooledCaser to reuse.
+func (c Caser) convert(s string, scratch []string) (string, []string) {
+	if result, ok := c.plainWord(s); ok {
+		return result, scratch
+	}
+	prefix, s, suffix := c.separatorAffixes(s)
+	if c.camelToSnake() {
+		if strings.IndexFunc(s, isWordRune) < 0 {
+			return prefix + suffix, scratch
+		}
+		return prefix + convertCamelToSnake(s) + suffix, scratch
+	}
+
+	words := c.mapWords(c.words(s))
+	if len(words) == 0 {
+		return prefix + suffix, scratch
+	}
+	components := c.caseWordsInto(scratch, words, nil)
+	return prefix + c.To.Join(components) + suffix, components
+}
+
+// ErrInvalidUTF8 is returned by StringErr for variable names that are not
+// valid UTF-8.
+var ErrInvalidUTF8 = fmt.Errorf("Invalid UTF-8.")
+
+// StringErr is like String, but returns an error wrapping ErrInvalidUTF8
+// instead of converting a variable name that is not valid UTF-8, whose
+// invalid bytes String would carry over into its result.
+func (c Caser) StringErr(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", fmt.Errorf("%w %q", ErrInvalidUTF8, s)
+	}
+	return c.String(s), nil
+}
+
+// Canonical returns a key for comparing variable names regardless of their
+// case convention: the words of s, after WordMap, in lowercase and joined
+// without their separators, so that "userID", "user_id" and "USER_ID" all
+// have the key "userid". Names with the same key may still differ in where
+// their words start, as "userid" and "user_id" do.
+func (c Caser) Canonical(s string) string {
+	var sb strings.Builder
+	for _, word := range c.mapWords(c.words(s)) {
+		for _, r := range word {
+			if isWordRune(r) {
+				sb.WriteRune(unicode.ToLower(r))
+			}
+		}
+	}
+	return sb.String()
+}
+
+// WriteString appends the result of String to sb. It saves the string that
+// String would allocate to put the preserved separators and the converted
+// words together.
+func (c Caser) WriteString(sb *strings.Builder, s string) {
+	if result, ok := c.plainWord(s); ok {
+		sb.WriteString(result)
+		return
+	}
+	prefix, s, suffix := c.separatorAffixes(s)
+
+	sb.WriteString(prefix)
+	if words := c.mapWords(c.words(s)); len(words) > 0 {
+		sb.WriteString(c.To.Join(c.caseWords(words)))
+	}
+	sb.WriteString(suffix)
+}
+
+// plainWord converts s without splitting and joining it if it is a single
+// word of lowercase ASCII letters, such as "count", and both of this Caser's
+// CaseConventions are known to leave such words intact. Converting it takes a
+// single call to the InitialCase of the To CaseConvention, and no allocations
+// if that doesn't change the word.
+func (c Caser) plainWord(s string) (string, bool) {
+	from, ok := c.From.(CaseConvention)
+	if !ok || !from.plainWords() || from.PostSplit != nil || !c.To.plainWords() || c.WordMap != nil || len(c.AtomicTokens) > 0 || s == "" {
+		return "", false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return "", false
+		}
+	}
+
+	result := c.To.InitialCase(s)
+	if c.To.KeepInitialisms && !isLower(result) {
+		if initialism, ok := c.initialism(s, false); ok {
+			result = initialism
+		}
+	}
+	if c.CaseRules != nil {
+		result = applyCaseRules(c.CaseRules, s, result)
+	}
+	return result, true
+}
+
+// mapWords applies WordMap to the words, if it is set.
+func (c Caser) mapWords(words []string) []string {
+	if c.WordMap == nil {
+		return words
+	}
+	mapped := make([]string, 0, len(words))
+	for i, word := range words {
+		if word = c.WordMap(i, word); word != "" {
+			mapped = append(mapped, word)
+		}
+	}
+	return mapped
+}
+
+// caseWords renders the words of a variable name in this Caser's To
+// CaseConvention.
+func (c Caser) caseWords(words []string) []string {
+	return c.caseWordsInto(nil, words, nil)
+}
+
+// caseWordsInto is like caseWords, but reuses the memory of dst, if it is
+// large enough, for the result. If acronyms is not nil, acronyms[i] is set
+// for each word i that is rendered as an initialism.
+func (c Caser) caseWordsInto(dst, words []string, acronyms []bool) []string {
+	components := slices.Grow(dst[:0], len(words))[:len(words)]
+	lowercase := true
+	for i, word := range words {
 		if i == 0 {
-			components = append(components, c.To.InitialCase(s))
+			components[i] = c.To.InitialCase(word)
 		} else {
-			components = append(components, c.To.SubsequentCase(s))
+			components[i] = c.To.SubsequentCase(word)
+		}
+		lowercase = lowercase && isLower(components[i])
+	}
+
+	if c.To.KeepInitialisms && !(lowercase && c.lowercaseTarget()) {
+		keepAllCaps := c.PreserveUnknownAllCaps && !allCaps(words)
+		keepSourceCaps := c.PreserveSourceCaps && !allCaps(words)
+		for i, word := range words {
+			if i > 0 || !isLower(components[i]) {
+				if initialism, ok := c.initialism(word, keepAllCaps); ok {
+					components[i] = initialism
+					if acronyms != nil {
+						acronyms[i] = true
+					}
+					continue
+				}
+			}
+			if keepSourceCaps && hasInnerUpper(word) {
+				components[i] = withSourceCaps(components[i], word)
+			}
+		}
+	}
+
+	if len(c.LowercaseShortWords) > 0 && c.titleTarget() {
+		for i, word := range words {
+			if i > 0 && c.isShortWord(word) {
+				components[i] = strings.ToLower(word)
+			}
+		}
+	}
+
+	if c.CaseRules != nil {
+		for i, word := range words {
+			components[i] = applyCaseRules(c.CaseRules, word, components[i])
 		}
 	}
-	return c.To.Join(components)
+
+	for i, word := range words {
+		if token, ok := c.atomicToken(word); ok {
+			components[i] = token
+			if acronyms != nil {
+				acronyms[i] = false
+			}
+		}
+	}
+	return components
+}
+
+// lowercaseTarget reports whether the To CaseConvention renders every word in
+// lowercase, like FlatCase does. Such conventions never get uppercase
+// initialisms, even if they are built on a JoinStyle or a CaseConvention
+// that keeps them.
+func (c Caser) lowercaseTarget() bool {
+	const probe = "Word"
+	return isLower(c.To.InitialCase(probe)) && isLower(c.To.SubsequentCase(probe))
+}
+
+// titleTarget reports whether the To CaseConvention capitalizes words after
+// the first one and separates them, so that lowercase words stay apart.
+func (c Caser) titleTarget() bool {
+	return c.To.SubsequentCase("word") == "Word" && c.To.Join([]string{"a", "b"}) != "ab"
+}
+
+// isShortWord reports whether word is one of LowercaseShortWords.
+func (c Caser) isShortWord(word string) bool {
+	for _, short := range c.LowercaseShortWords {
+		if strings.EqualFold(word, short) {
+			return true
+		}
+	}
+	return false
+}
+
+// initialism returns the canonical form of word if it is in the acronym map,
+// word in uppercase if it is an initialism, or in uppercase with a lowercase
+// "s" if it is the plural of one. An initialism followed by a version tag, as
+// in "APIv2", keeps the tag as it is. If keepAllCaps, words in all caps count
+// as initialisms too.
+func (c Caser) initialism(word string, keepAllCaps bool) (string, bool) {
+	if c.acronyms != nil {
+		if canonical, ok := c.acronyms[strings.ToLower(word)]; ok {
+			return canonical, true
+		}
+	}
+	initialisms := c.effectiveInitialisms()
+	if isInitialisms(word, initialisms) || (keepAllCaps && isAllCaps(word)) {
+		return strings.ToUpper(word), c.longEnough(word)
+	}
+	if singular, ok := pluralInitialism(word, initialisms); ok {
+		return singular + "s", c.longEnough(singular)
+	}
+	if base, version, ok := cutVersion(word); ok {
+		if initialism, ok := c.initialism(base, keepAllCaps); ok {
+			return initialism + version, true
+		}
+	}
+	return "", false
+}
+
+// longEnough reports whether initialism has at least MinAcronymLength runes.
+func (c Caser) longEnough(initialism string) bool {
+	return utf8.RuneCountInString(initialism) >= c.MinAcronymLength
+}
+
+// separatorAffixes splits s into the leading and trailing runs of separators
+// that this Caser preserves, and the rest of s.
+func (c Caser) separatorAffixes(s string) (prefix, core, suffix string) {
+	isSeparator := func(r rune) bool {
+		return !isWordRune(r)
+	}
+
+	core = s
+	if c.PreserveLeadingSeparator {
+		trimmed := strings.TrimLeftFunc(core, isSeparator)
+		prefix, core = core[:len(core)-len(trimmed)], trimmed
+	}
+	if c.PreserveTrailingSeparator {
+		trimmed := strings.TrimRightFunc(core, isSeparator)
+		core, suffix = trimmed, core[len(trimmed):]
+	}
+	return prefix, core, suffix
+}
+
+// StringQualified converts each of the sep-separated segments of a qualified
+// name, such as "pkg.subPkg.myVar", on its own, and joins the results with sep
+// again. An empty sep converts s as a whole, like String.
+func (c Caser) StringQualified(s string, sep string) string {
+	if sep == "" {
+		return c.String(s)
+	}
+	segments := strings.Split(s, sep)
+	for i, segment := range segments {
+		segments[i] = c.String(segment)
+	}
+	return strings.Join(segments, sep)
+}
+
+// StringIfNeeded returns s unchanged and false if it is already in this
+// Caser's To CaseConvention, meaning that To splits it into words of letters
+// and digits that it renders as s again. Otherwise it returns the result of
+// String and whether that differs from s. This keeps names that are already
+// correct from being rewritten, for example when the From CaseConvention
+// would misread them.
+func (c Caser) StringIfNeeded(s string) (string, bool) {
+	if c.inToConvention(s) {
+		return s, false
+	}
+	result := c.String(s)
+	return result, result != s
+}
+
+// inToConvention reports whether s is already in this Caser's To
+// CaseConvention, as StringIfNeeded describes.
+func (c Caser) inToConvention(s string) bool {
+	target := c
+	target.From = c.To
+	for _, word := range target.Words(s) {
+		if strings.IndexFunc(word, func(r rune) bool { return !isWordRune(r) }) >= 0 {
+			return false
+		}
+	}
+	return target.String(s) == s
+}
+
+// StringSlice returns the result of String for each of the variable names, in
+// the same order. The input slice is not modified.
+func (c Caser) StringSlice(in []string) []string {
+	if in == nil {
+		return nil
+	}
+	out := make([]string, len(in))
+	for i, s := range in {
+		out[i] = c.String(s)
+	}
+	return out
+}
+
+// Mapping returns a map from each of the variable names to the result of
+// String for it. Names that occur more than once are converted once. The map
+// is never nil. See DetectCollisions for names that convert to the same
+// result.
+func (c Caser) Mapping(names ...string) map[string]string {
+	mapping := make(map[string]string, len(names))
+	for _, name := range names {
+		if _, ok := mapping[name]; !ok {
+			mapping[name] = c.String(name)
+		}
+	}
+	return mapping
+}
+
+// RoundTrips reports whether converting s from this Caser's From to its To
+// CaseConvention and back gives s again. Conversions to conventions without
+// reliable separators, such as FlatCase, may legitimately not round-trip.
+// RoundTrips returns false if From is not a CaseConvention, since there is no
+// way to convert back.
+func (c Caser) RoundTrips(s string) bool {
+	from, ok := c.From.(CaseConvention)
+	if !ok {
+		return false
+	}
+
+	back := c
+	back.From = c.To
+	back.To = from
+	return back.String(c.String(s)) == s
+}
+
+// Then returns a Caser that converts variable names with c and then with next.
+// The result is next, with its From Splitter applied to the output of c.
+func (c Caser) Then(next Caser) Caser {
+	next.From = pipeSplitter{first: c, next: next.From}
+	return next
+}
+
+// pipeSplitter is the Splitter of a Caser returned by Then.
+type pipeSplitter struct {
+	first Caser
+	next  Splitter
+}
+
+// SplitWords allows pipeSplitter to implement Splitter.
+func (p pipeSplitter) SplitWords(s string) []string {
+	return p.next.SplitWords(p.first.String(s))
+}
+
+// Words returns the words of a variable name in this Caser's From Splitter, as
+// String finds them before rendering them in the To CaseConvention: split by
+// From, with empty words handled according to EmptyComponents, further split
+// according to LenientSplit and Digits, unless CaseOnlySplit replaces From,
+// and without the separators kept by PreserveLeadingSeparator and
+// PreserveTrailingSeparator. The returned slice is a fresh copy that the
+// caller may modify.
+func (c Caser) Words(s string) []string {
+	_, s, _ = c.separatorAffixes(s)
+	return append([]string(nil), c.words(s)...)
+}
+
+// words decomposes a variable name into the words that are rendered in this
+// Caser's To CaseConvention. A name without letters or digits, such as "",
+// "  " or "__", has no words at all, whatever the From Splitter makes of it.
+func (c Caser) words(s string) []string {
+	if c.NormalizeUnicode {
+		s = norm.NFC.String(s)
+	}
+	if strings.IndexFunc(s, isWordRune) < 0 {
+		return nil
+	}
+	if len(c.AtomicTokens) > 0 {
+		return c.atomicWords(s)
+	}
+	return c.splitWords(s)
+}
+
+// splitWords is words for a name without AtomicTokens.
+func (c Caser) splitWords(s string) []string {
+	if c.CaseOnlySplit {
+		return splitDigits(camelJoinStyle.Split(s), c.digitStyle(), c.effectiveInitialisms())
+	}
+	words := handleEmptyComponents(c.From.SplitWords(s), c.EmptyComponents)
+	if c.LenientSplit {
+		words = lenientSplit(words)
+	}
+	return splitDigits(words, c.digitStyle(), c.effectiveInitialisms())
+}
+
+// digitStyle returns the DigitStyle that this Caser splits words with.
+func (c Caser) digitStyle() DigitStyle {
+	if c.NumbersAreSeparators {
+		return DigitsSeparate
+	}
+	return c.Digits
 }
 
-// Bytes is provided for compatibility with the Transformer interface. Since
-// Caser has no special treatment of bytes, the bytes are converted to and from
-// strings.
+// isWordRune reports whether r is a letter or a digit, rather than a
+// separator.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// hasInnerUpper reports whether word has an uppercase letter after its first
+// rune.
+func hasInnerUpper(word string) bool {
+	_, size := utf8.DecodeRuneInString(word)
+	return strings.IndexFunc(word[size:], unicode.IsUpper) >= 0
+}
+
+// withSourceCaps returns the first rune of cased followed by the rest of word.
+func withSourceCaps(cased, word string) string {
+	_, casedSize := utf8.DecodeRuneInString(cased)
+	_, wordSize := utf8.DecodeRuneInString(word)
+	return cased[:casedSize] + word[wordSize:]
+}
+
+// isAllCaps reports whether word has at least two letters and no lowercase
+// ones.
+func isAllCaps(word string) bool {
+	letters := 0
+	for _, r := range word {
+		if unicode.IsLower(r) {
+			return false
+		}
+		if unicode.IsLetter(r) {
+			letters++
+		}
+	}
+	return letters >= 2
+}
+
+// allCaps reports whether none of the words have lowercase letters.
+func allCaps(words []string) bool {
+	for _, word := range words {
+		if strings.IndexFunc(word, unicode.IsLower) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// applyCaseRules redoes the case mappings that turned word into cased using
+// rules. The mappings are inferred rune by rune, so if the word case changed
+// the number of runes, cased is returned unchanged.
+func applyCaseRules(rules unicode.SpecialCase, word, cased string) string {
+	if utf8.RuneCountInString(word) != utf8.RuneCountInString(cased) {
+		return cased
+	}
+
+	result := make([]rune, 0, len(cased))
+	for _, r := range word {
+		mapped, size := utf8.DecodeRuneInString(cased)
+		cased = cased[size:]
+
+		switch {
+		case mapped == r:
+			// Left alone by the word case.
+		case mapped == unicode.ToUpper(r):
+			mapped = rules.ToUpper(r)
+		case mapped == unicode.ToLower(r):
+			mapped = rules.ToLower(r)
+		case mapped == unicode.ToTitle(r):
+			mapped = rules.ToTitle(r)
+		}
+		result = append(result, mapped)
+	}
+	return string(result)
+}
+
+// Bytes is provided for compatibility with the Transformer interface. The
+// result is always a freshly allocated slice, with the same contents as String
+// would return. Conversions that String specializes, such as from
+// LowerCamelCase to LowerSnakeCase, are done on the bytes directly; others
+// convert b to a string first, since the functions of c may keep the strings
+// they are passed.
 func (c Caser) Bytes(b []byte) []byte {
+	if c.camelToSnake() {
+		// Nothing outside this package sees s, which shares the memory of b.
+		s := unsafe.String(unsafe.SliceData(b), len(b))
+		prefix, core, suffix := c.separatorAffixes(s)
+		result := make([]byte, 0, len(b)+len(b)/4)
+		result = append(result, prefix...)
+		if strings.IndexFunc(core, isWordRune) >= 0 {
+			result = appendCamelToSnake(result, core)
+		}
+		return append(result, suffix...)
+	}
 	return []byte(c.String(string(b)))
 }
 
@@ -0,0 +1,56 @@
+package varcaser
+
+// This file defines how a Caser treats empty components between separators.
+
+// An EmptyComponentStyle determines what happens to the empty components that
+// a From Splitter finds between doubled separators, as in "foo__bar". Empty
+// components at the start or end of a variable name, as in "_private", are
+// always kept.
+type EmptyComponentStyle int
+
+const (
+	// EmptyComponentsDrop removes empty components, so "foo__bar" becomes
+	// ["foo", "bar"]. This is the default.
+	EmptyComponentsDrop EmptyComponentStyle = iota
+
+	// EmptyComponentsCollapse replaces every run of empty components with
+	// a single one, so "foo___bar" becomes ["foo", "", "bar"].
+	EmptyComponentsCollapse
+
+	// EmptyComponentsPreserve keeps every empty component, so that
+	// "foo__bar" converts back to itself.
+	EmptyComponentsPreserve
+)
+
+// handleEmptyComponents removes the empty components between the first and
+// last non-empty ones according to style.
+func handleEmptyComponents(words []string, style EmptyComponentStyle) []string {
+	if style == EmptyComponentsPreserve {
+		return words
+	}
+
+	first, last := -1, -1
+	for i, word := range words {
+		if word != "" {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		return words
+	}
+
+	components := append([]string{}, words[:first]...)
+	for i := first; i <= last; i++ {
+		if words[i] != "" {
+			components = append(components, words[i])
+			continue
+		}
+		if style == EmptyComponentsCollapse && words[i-1] != "" {
+			components = append(components, "")
+		}
+	}
+	return append(components, words[last+1:]...)
+}
@@ -0,0 +1,29 @@
+package varcaser
+
+import "testing"
+
+func TestCaserEmptyComponentsDrop(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerSnakeCase}
+	AssertEqual(c.String("foo__bar"), "foo_bar", t)
+	AssertEqual(c.String("foo___bar"), "foo_bar", t)
+	AssertEqual(c.String("_private__name"), "_private_name", t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: KebabCase}.String("foo__bar"), "foo-bar", t)
+}
+
+func TestCaserEmptyComponentsCollapse(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: KebabCase, EmptyComponents: EmptyComponentsCollapse}
+	AssertEqual(c.String("foo__bar"), "foo--bar", t)
+	AssertEqual(c.String("foo___bar"), "foo--bar", t)
+	AssertEqual(c.String("foo_bar"), "foo-bar", t)
+}
+
+func TestCaserEmptyComponentsPreserve(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerSnakeCase, EmptyComponents: EmptyComponentsPreserve}
+	AssertEqual(c.String("foo__bar"), "foo__bar", t)
+	AssertEqual(c.String("foo___bar"), "foo___bar", t)
+	AssertEqual(c.RoundTrips("foo__bar"), true, t)
+
+	toKebab := Caser{From: LowerSnakeCase, To: KebabCase, EmptyComponents: EmptyComponentsPreserve}
+	AssertEqual(toKebab.String("foo__bar"), "foo--bar", t)
+	AssertEqual(toKebab.Then(Caser{From: KebabCase, To: LowerSnakeCase, EmptyComponents: EmptyComponentsPreserve}).String("foo__bar"), "foo__bar", t)
+}
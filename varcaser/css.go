@@ -0,0 +1,50 @@
+package varcaser
+
+// This file defines conversions between CSS custom properties and JavaScript
+// names.
+
+import "strings"
+
+// CSSVarToJS converts a CSS custom property, such as "--my-custom-prop", to
+// the camel case name used from JavaScript, "myCustomProp". Like the CSSOM
+// camel-case conversion, it removes every hyphen that is followed by a
+// lowercase ASCII letter and uppercases that letter, and leaves any other
+// hyphen alone. The leading "--" is stripped first, or a leading "-" for
+// vendor-prefixed names, so "-webkit-box" becomes "webkitBox".
+func CSSVarToJS(s string) string {
+	if strings.HasPrefix(s, "--") {
+		s = s[2:]
+	} else {
+		s = strings.TrimPrefix(s, "-")
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '-' && i+1 < len(s) && 'a' <= s[i+1] && s[i+1] <= 'z' {
+			i++
+			c = s[i] - ('a' - 'A')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// JSToCSSVar is the inverse of CSSVarToJS. It converts a camel case name such
+// as "myCustomProp" to the CSS custom property "--my-custom-prop", replacing
+// every uppercase ASCII letter with a hyphen and the lowercase letter.
+func JSToCSSVar(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 4)
+	b.WriteString("--")
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' {
+			b.WriteByte('-')
+			c += 'a' - 'A'
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
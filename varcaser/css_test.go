@@ -0,0 +1,27 @@
+package varcaser
+
+import "testing"
+
+func TestCSSVarToJS(t *testing.T) {
+	AssertEqual(CSSVarToJS("--my-custom-prop"), "myCustomProp", t)
+	AssertEqual(CSSVarToJS("--foo"), "foo", t)
+	AssertEqual(CSSVarToJS("--webkit-box"), "webkitBox", t)
+	AssertEqual(CSSVarToJS("-webkit-box"), "webkitBox", t)
+	AssertEqual(CSSVarToJS("background-color"), "backgroundColor", t)
+
+	// Only hyphens before lowercase ASCII letters are removed.
+	AssertEqual(CSSVarToJS("--x-1"), "x-1", t)
+	AssertEqual(CSSVarToJS("--foo-Bar"), "foo-Bar", t)
+	AssertEqual(CSSVarToJS("--foo--bar"), "foo-Bar", t)
+	AssertEqual(CSSVarToJS("--foo-"), "foo-", t)
+}
+
+func TestJSToCSSVar(t *testing.T) {
+	AssertEqual(JSToCSSVar("myCustomProp"), "--my-custom-prop", t)
+	AssertEqual(JSToCSSVar("foo"), "--foo", t)
+	AssertEqual(JSToCSSVar("webkitBox"), "--webkit-box", t)
+
+	for _, name := range []string{"--my-custom-prop", "--foo", "--webkit-box", "--a-b-c"} {
+		AssertEqual(JSToCSSVar(CSSVarToJS(name)), name, t)
+	}
+}
@@ -3,7 +3,10 @@ package varcaser
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // ErrNoData is returned when an empty or nil slice is passed in.
@@ -109,3 +112,191 @@ func UpdateJoinStylePrediction(data string, sep rune) (rune, error) {
 		return 0, nil
 	}
 }
+
+// DetectConvention returns the predefined CaseConvention that best matches a
+// single variable name, along with whether the match is unambiguous. Names
+// that match several conventions, such as the single word "foo", return a best
+// guess and false. Names that match no predefined convention return the zero
+// CaseConvention and false.
+func DetectConvention(s string) (CaseConvention, bool) {
+	var sep rune
+	for _, r := range s {
+		if isWordRune(r) {
+			continue
+		}
+		if !strings.ContainsRune("_-. /:", r) || sep != 0 && r != sep {
+			return CaseConvention{}, false
+		}
+		sep = r
+	}
+
+	if sep == 0 {
+		return detectUnseparatedConvention(s)
+	}
+
+	separator := string(sep)
+	if sep == ':' {
+		// Colons only separate words in pairs.
+		separator = "::"
+		if strings.Contains(strings.ReplaceAll(s, separator, ""), ":") {
+			return CaseConvention{}, false
+		}
+	}
+
+	words := SimpleJoinStyle(separator).Split(s)
+	lower, upper, title, sentence := true, true, true, true
+	for i, word := range words {
+		lower = lower && word == strings.ToLower(word)
+		upper = upper && word == strings.ToUpper(word)
+		title = title && word == ToStrictTitle(word)
+		if i == 0 {
+			sentence = word == ToStrictTitle(word)
+		} else {
+			sentence = sentence && word == strings.ToLower(word)
+		}
+	}
+
+	switch {
+	case sep == '_' && lower:
+		return LowerSnakeCase, true
+	case sep == '_' && upper:
+		return ScreamingSnakeCase, true
+	case sep == '-' && lower:
+		return KebabCase, true
+	case sep == '-' && upper:
+		return ScreamingKebabCase, true
+	case sep == '-' && title:
+		return TrainCase, true
+	case sep == '.' && lower:
+		return DotLowerCase, true
+	case sep == '.' && upper:
+		return DotScreamingCase, true
+	case sep == ' ' && title:
+		return TitleSpaceCase, true
+	case sep == ' ' && sentence:
+		return SentenceCase, true
+	case sep == '/' && lower:
+		return SlashLowerCase, true
+	case sep == '/' && upper:
+		return SlashScreamingCase, true
+	case sep == ':' && title:
+		return DoubleColonCase, true
+	}
+	return CaseConvention{}, false
+}
+
+// detectUnseparatedConvention is DetectConvention for names without a
+// separator. A name with any rune that is not a letter or a digit, such as a
+// space, is not reported to be in a camel case convention.
+func detectUnseparatedConvention(s string) (CaseConvention, bool) {
+	if s == "" || strings.IndexFunc(s, func(r rune) bool { return !isWordRune(r) }) >= 0 {
+		return CaseConvention{}, false
+	}
+
+	words := camelJoinStyle.Split(s)
+	first, _ := utf8.DecodeRuneInString(s)
+	if len(words) > 1 {
+		if unicode.IsUpper(first) {
+			return UpperCamelCase, true
+		}
+		return LowerCamelCase, true
+	}
+
+	// A single word could be in any convention of matching case.
+	switch s {
+	case strings.ToLower(s):
+		return LowerSnakeCase, false
+	case strings.ToUpper(s):
+		return ScreamingSnakeCase, false
+	}
+	return UpperCamelCase, false
+}
+
+// ConvertAuto is like String, but ignores the From Splitter of c and splits
+// s according to the CaseConvention that DetectConvention finds for it, so
+// that names in different conventions, such as "userID", "user_id" and
+// "user-id", convert alike. Names whose convention is uncertain, such as
+// those mixing separators, are split on case changes and on every rune that
+// is not a letter or a digit instead.
+func (c Caser) ConvertAuto(s string) string {
+	if convention, ok := DetectConvention(s); ok {
+		c.From = convention
+	} else {
+		c.From = Detected{Split: splitAnySeparator}
+	}
+	return c.String(s)
+}
+
+// splitAnySeparator splits s on case changes and on every rune that is not a
+// letter or a digit.
+func splitAnySeparator(s string) []string {
+	words := []string{}
+	for _, field := range strings.FieldsFunc(s, func(r rune) bool { return !isWordRune(r) }) {
+		words = append(words, camelJoinStyle.Split(field)...)
+	}
+	return words
+}
+
+// A DetectResult is a candidate CaseConvention for a variable name, as
+// returned by DetectAll. Its Score is between 0 and 1, and higher for better
+// matches.
+type DetectResult struct {
+	Convention CaseConvention
+	Score      float64
+}
+
+// DetectAll returns the predefined CaseConventions that a variable name may be
+// in, best matches first. Conventions that render the name as it is score 1
+// if it has several words, and 0.5 if it is a single word, which could be in
+// any of them. Conventions whose rendering of the words of the name only
+// shares its separators, or only the case of its letters, score 0.2, and
+// those sharing neither are left out. Candidates with the same score are in
+// the order the conventions are defined in. Names without letters or digits
+// have no candidates.
+func DetectAll(s string) []DetectResult {
+	if strings.IndexFunc(s, isWordRune) < 0 {
+		return nil
+	}
+	letters := strings.Map(keepRunes(isWordRune), s)
+	separators := strings.Map(keepRunes(func(r rune) bool { return !isWordRune(r) }), s)
+
+	results := []DetectResult{}
+	for _, convention := range predefinedConventions {
+		c := Caser{From: convention, To: convention, LenientSplit: true}
+		rendered := c.String(s)
+
+		var score float64
+		switch {
+		case rendered == s && len(c.Words(s)) > 1:
+			score = 1
+		case rendered == s:
+			score = 0.5
+		default:
+			if strings.Map(keepRunes(isWordRune), rendered) == letters {
+				score += 0.2
+			}
+			if strings.Map(keepRunes(func(r rune) bool { return !isWordRune(r) }), rendered) == separators {
+				score += 0.2
+			}
+		}
+		if score > 0 {
+			results = append(results, DetectResult{Convention: convention, Score: score})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results
+}
+
+// keepRunes returns a mapping for strings.Map that keeps the runes for which
+// keep returns true, and drops the others.
+func keepRunes(keep func(rune) bool) func(rune) rune {
+	return func(r rune) rune {
+		if keep(r) {
+			return r
+		}
+		return -1
+	}
+}
@@ -44,3 +44,130 @@ func TestDetectorHyphenLowerTitleCase(t *testing.T) {
 	AssertEqual(c.SplitWords("a_B"), []string{"a_B"}, t)
 	AssertEqual(c.SplitWords("a-B-c"), []string{"a", "B", "c"}, t)
 }
+
+func TestDetectConvention(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected CaseConvention
+	}{
+		{"my_var", LowerSnakeCase},
+		{"_my_var", LowerSnakeCase},
+		{"MY_VAR", ScreamingSnakeCase},
+		{"my-var", KebabCase},
+		{"MY-VAR", ScreamingKebabCase},
+		{"My-Var", TrainCase},
+		{"my.var", DotLowerCase},
+		{"MyVar", UpperCamelCase},
+		{"myVar", LowerCamelCase},
+		{"myHTTPVar", LowerCamelCase},
+		{"My Var", TitleSpaceCase},
+		{"My var", SentenceCase},
+		{"my/var", SlashLowerCase},
+		{"MY/VAR", SlashScreamingCase},
+		{"My::Var", DoubleColonCase},
+	}
+
+	for _, tc := range cases {
+		c, ok := DetectConvention(tc.input)
+		AssertEqual(ok, true, t)
+		AssertEqual(c.Example, tc.expected.Example, t)
+	}
+}
+
+func TestDetectConventionAmbiguous(t *testing.T) {
+	c, ok := DetectConvention("foo")
+	AssertEqual(ok, false, t)
+	AssertEqual(c.Example, LowerSnakeCase.Example, t)
+
+	c, ok = DetectConvention("FOO")
+	AssertEqual(ok, false, t)
+	AssertEqual(c.Example, ScreamingSnakeCase.Example, t)
+
+	c, ok = DetectConvention("Foo")
+	AssertEqual(ok, false, t)
+	AssertEqual(c.Example, UpperCamelCase.Example, t)
+}
+
+func TestDetectConventionNoMatch(t *testing.T) {
+	for _, input := range []string{"", "my_var-name", "my_Var", "My+Var", "My:Var", "my:::var", "HTTP.Server"} {
+		_, ok := DetectConvention(input)
+		AssertEqual(ok, false, t)
+	}
+}
+
+// detectedExamples returns the examples of the conventions in results that
+// have the given score.
+func detectedExamples(results []DetectResult, score float64) []string {
+	examples := []string{}
+	for _, result := range results {
+		if result.Score == score {
+			examples = append(examples, result.Convention.Example)
+		}
+	}
+	return examples
+}
+
+func TestDetectAllSnake(t *testing.T) {
+	results := DetectAll("foo_bar")
+	AssertEqual(results[0].Convention.Example, LowerSnakeCase.Example, t)
+	AssertEqual(results[0].Score, 1.0, t)
+	AssertEqual(detectedExamples(results, 1), []string{"lower_snake_case"}, t)
+
+	// Conventions sharing the underscores or the lowercase letters.
+	AssertEqual(results[1].Score < 1, true, t)
+	AssertEqual(detectedExamples(results, 0.2)[:2], []string{"SCREAMING_SNAKE_CASE", "kebab-case"}, t)
+}
+
+func TestDetectAllCamel(t *testing.T) {
+	results := DetectAll("fooBar")
+	AssertEqual(detectedExamples(results, 1), []string{"lowerCamelCase", "lowerCamelCaseKeepCaps"}, t)
+	AssertEqual(results[2].Score < 1, true, t)
+
+	results = DetectAll("FooBarID")
+	AssertEqual(results[0].Convention.Example, UpperCamelCase.Example, t)
+	AssertEqual(results[0].Score, 1.0, t)
+}
+
+func TestDetectAllAmbiguous(t *testing.T) {
+	results := DetectAll("foo")
+	AssertEqual(results[0].Score, 0.5, t)
+	AssertEqual(detectedExamples(results, 0.5), []string{
+		"lower_snake_case", "kebab-case", "lowerCamelCase", "lowerCamelCaseKeepCaps",
+		"dot.lower.case", "flatcase", "camel_Snake_Case", "slash/lower/case",
+	}, t)
+	AssertEqual(len(detectedExamples(results, 1)), 0, t)
+
+	for i := 1; i < len(results); i++ {
+		AssertEqual(results[i-1].Score >= results[i].Score, true, t)
+	}
+	AssertEqual(len(DetectAll("")), 0, t)
+	AssertEqual(len(DetectAll("__")), 0, t)
+}
+
+func TestCaserConvertAuto(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+	for _, s := range []string{"userID", "UserID", "user_id", "USER_ID", "user-id", "User-Id", "user.id"} {
+		AssertEqual(c.ConvertAuto(s), "userID", t)
+	}
+
+	c.To = KebabCase
+	for _, s := range []string{"httpServerPort", "HTTPServerPort", "http_server_port", "HTTP-SERVER-PORT"} {
+		AssertEqual(c.ConvertAuto(s), "http-server-port", t)
+	}
+
+	// Mixed separators can't be detected, and are split leniently.
+	AssertEqual(c.ConvertAuto("http_server-port"), "http-server-port", t)
+	AssertEqual(c.ConvertAuto("my-var_name value"), "my-var-name-value", t)
+	AssertEqual(c.ConvertAuto("count"), "count", t)
+
+	// Other separators are detected, or split on when they can't be.
+	AssertEqual(c.ConvertAuto("Hello World"), "hello-world", t)
+	AssertEqual(c.ConvertAuto("Foo::Bar"), "foo-bar", t)
+	AssertEqual(c.ConvertAuto("HTTP.Server"), "http-server", t)
+	AssertEqual(c.ConvertAuto("userName+Id"), "user-name-id", t)
+	AssertEqual(c.ConvertAuto(""), "", t)
+
+	// From is ignored, but the other options are kept.
+	c.PreserveLeadingSeparator = true
+	AssertEqual(c.ConvertAuto("_userName"), "_user-name", t)
+}
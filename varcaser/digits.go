@@ -0,0 +1,55 @@
+package varcaser
+
+// This file defines how a Caser treats digits inside words.
+
+import "unicode"
+
+// A DigitStyle determines whether runs of digits are split off the words
+// produced by a Caser's From Splitter.
+type DigitStyle int
+
+const (
+	// DigitsJoinPrevious leaves digits in the word they were split into,
+	// so "version2Api" becomes ["version2", "Api"]. This is the default.
+	DigitsJoinPrevious DigitStyle = iota
+
+	// DigitsStartWord starts a new word at every run of digits that
+	// follows a letter, so "foo2bar" becomes ["foo", "2bar"].
+	DigitsStartWord
+
+	// DigitsSeparate makes every run of digits its own word, so
+	// "version2Api" becomes ["version", "2", "Api"].
+	DigitsSeparate
+)
+
+// splitDigits splits runs of digits off each of the words according to style.
+// Words that are known initialisms, such as UTF8, are left intact.
+func splitDigits(words []string, style DigitStyle, initialisms *initialismSet) []string {
+	if style == DigitsJoinPrevious {
+		return words
+	}
+
+	components := []string{}
+	for _, word := range words {
+		if isInitialisms(word, initialisms) {
+			components = append(components, word)
+			continue
+		}
+
+		current := []rune{}
+		wasPreviousDigit := false
+		for i, r := range []rune(word) {
+			isDigit := unicode.IsDigit(r)
+			startsDigits := isDigit && !wasPreviousDigit
+			endsDigits := !isDigit && wasPreviousDigit && style == DigitsSeparate
+			if i > 0 && (startsDigits || endsDigits) {
+				components = append(components, string(current))
+				current = []rune{}
+			}
+			current = append(current, r)
+			wasPreviousDigit = isDigit
+		}
+		components = append(components, string(current))
+	}
+	return components
+}
@@ -0,0 +1,83 @@
+package varcaser
+
+import "testing"
+
+func TestCamelSplitDigitAcronyms(t *testing.T) {
+	AssertEqual(camelJoinStyle.Split("readUTF8Buffer"), []string{"read", "UTF8", "Buffer"}, t)
+	AssertEqual(camelJoinStyle.Split("UTF8Reader"), []string{"UTF8", "Reader"}, t)
+	AssertEqual(camelJoinStyle.Split("parseMD5Hash"), []string{"parse", "MD5", "Hash"}, t)
+	AssertEqual(camelJoinStyle.Split("sha256Sum"), []string{"sha256", "Sum"}, t)
+	AssertEqual(camelJoinStyle.Split("SHA256Sum"), []string{"SHA256", "Sum"}, t)
+	// A word that ends in a digit keeps the letters that follow it.
+	AssertEqual(camelJoinStyle.Split("MD5hash"), []string{"MD5hash"}, t)
+}
+
+func TestCaserDigitAcronyms(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: UpperCamelCase}
+
+	AssertEqual(c.String("readUtf8Buffer"), "ReadUTF8Buffer", t)
+	AssertEqual(c.String("readUTF8Buffer"), "ReadUTF8Buffer", t)
+	AssertEqual(c.String("parseMD5Hash"), "ParseMD5Hash", t)
+	AssertEqual(c.String("sha256Sum"), "SHA256Sum", t)
+	AssertEqual(c.String("sha1Digest"), "SHA1Digest", t)
+	AssertEqual(c.WithoutInitialisms("MD5").String("parseMD5Hash"), "ParseMd5Hash", t)
+}
+
+func TestCaserDigitAcronymsToSnake(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+
+	AssertEqual(c.String("readUTF8Buffer"), "read_utf8_buffer", t)
+	AssertEqual(c.String("parseMD5Hash"), "parse_md5_hash", t)
+	AssertEqual(c.String("sha256Sum"), "sha256_sum", t)
+}
+
+func TestCaserDigitAcronymsSeparate(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase, Digits: DigitsSeparate}
+
+	AssertEqual(c.String("readUTF8Buffer"), "read_utf8_buffer", t)
+	AssertEqual(c.String("sha256Sum"), "sha256_sum", t)
+	AssertEqual(c.String("parseMD5Hash"), "parse_md5_hash", t)
+	AssertEqual(c.String("version10Api"), "version_10_api", t)
+	AssertEqual(c.WithoutInitialisms("MD5").String("parseMD5Hash"), "parse_md_5_hash", t)
+}
+
+func TestCaserNumbersAreSeparators(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase, NumbersAreSeparators: true}
+
+	AssertEqual(c.String("address1line2"), "address_1_line_2", t)
+	AssertEqual(c.Words("address1line2"), []string{"address", "1", "line", "2"}, t)
+	AssertEqual(c.String("version10Api"), "version_10_api", t)
+
+	AssertEqual(c.String("readUTF8Buffer"), "read_utf8_buffer", t)
+	// Known initialisms with digits stay whole.
+	AssertEqual(c.String("parseMD5Hash"), "parse_md5_hash", t)
+	AssertEqual(c.String("sha256Sum"), "sha256_sum", t)
+	AssertEqual(c.String("computeSHA1"), "compute_sha1", t)
+	AssertEqual(c.WithoutInitialisms("MD5").String("parseMD5Hash"), "parse_md_5_hash", t)
+}
+
+func TestCamelSplitLeadingDigits(t *testing.T) {
+	AssertEqual(camelJoinStyle.Split("2ndPlace"), []string{"2nd", "Place"}, t)
+	AssertEqual(camelJoinStyle.Split("3DModel"), []string{"3D", "Model"}, t)
+	AssertEqual(camelJoinStyle.Split("007Agent"), []string{"007", "Agent"}, t)
+	AssertEqual(camelJoinStyle.Split("2Place"), []string{"2", "Place"}, t)
+	AssertEqual(camelJoinStyle.Split("007"), []string{"007"}, t)
+}
+
+func TestCaserLeadingDigits(t *testing.T) {
+	toSnake := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	AssertEqual(toSnake.String("2ndPlace"), "2nd_place", t)
+	AssertEqual(toSnake.String("3DModel"), "3d_model", t)
+	AssertEqual(toSnake.String("007Agent"), "007_agent", t)
+
+	toCamel := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+	AssertEqual(toCamel.String("2nd_place"), "2ndPlace", t)
+	AssertEqual(toCamel.String("007_agent"), "007Agent", t)
+
+	toKebab := Caser{From: UpperCamelCase, To: KebabCase}
+	AssertEqual(toKebab.String("3DModel"), "3d-model", t)
+
+	separate := Caser{From: LowerCamelCase, To: LowerSnakeCase, Digits: DigitsSeparate}
+	AssertEqual(separate.String("2ndPlace"), "2_nd_place", t)
+	AssertEqual(separate.String("007Agent"), "007_agent", t)
+}
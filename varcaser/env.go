@@ -0,0 +1,58 @@
+package varcaser
+
+// This file defines helpers for environment variable names.
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToEnvVar converts s to an environment variable name with c, which would
+// usually convert to ScreamingSnakeCase:
+//
+//	ToEnvVar(Caser{From: LowerCamelCase, To: ScreamingSnakeCase}, "maxRetries")
+//	// "MAX_RETRIES"
+func ToEnvVar(c Caser, s string) string {
+	return ToPrefixedEnvVar(c, "", s)
+}
+
+// ToPrefixedEnvVar is like ToEnvVar, but puts prefix in front of the name,
+// separated by an underscore, so "timeout" with the prefix "MYAPP" becomes
+// "MYAPP_TIMEOUT". An empty prefix adds nothing.
+func ToPrefixedEnvVar(c Caser, prefix, s string) string {
+	if prefix == "" {
+		return c.String(s)
+	}
+	return prefix + "_" + c.String(s)
+}
+
+// FromEnvVar strips prefix and the underscore after it from the environment
+// variable name and converts the rest with c, which would usually convert
+// from ScreamingSnakeCase. It returns false if the name doesn't start with
+// prefix.
+func FromEnvVar(c Caser, prefix, name string) (string, bool) {
+	if prefix != "" {
+		if !strings.HasPrefix(name, prefix+"_") {
+			return "", false
+		}
+		name = name[len(prefix)+1:]
+	}
+	return c.String(name), true
+}
+
+// ToEnvVars returns a map from each of the names to its environment variable
+// name, as ToPrefixedEnvVar returns it. If two different names map to the same
+// environment variable, it returns an error naming them.
+func ToEnvVars(c Caser, prefix string, names []string) (map[string]string, error) {
+	vars := map[string]string{}
+	sources := map[string]string{}
+	for _, name := range names {
+		envVar := ToPrefixedEnvVar(c, prefix, name)
+		if source, ok := sources[envVar]; ok && source != name {
+			return nil, fmt.Errorf("Both %q and %q map to %q.", source, name, envVar)
+		}
+		sources[envVar] = name
+		vars[name] = envVar
+	}
+	return vars, nil
+}
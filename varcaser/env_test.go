@@ -0,0 +1,47 @@
+package varcaser
+
+import "testing"
+
+func TestToEnvVar(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: ScreamingSnakeCase}
+
+	AssertEqual(ToEnvVar(c, "maxRetries"), "MAX_RETRIES", t)
+	AssertEqual(ToEnvVar(c, "http2Enabled"), "HTTP2_ENABLED", t)
+	AssertEqual(ToPrefixedEnvVar(c, "MYAPP", "timeout"), "MYAPP_TIMEOUT", t)
+	AssertEqual(ToPrefixedEnvVar(c, "", "timeout"), "TIMEOUT", t)
+}
+
+func TestFromEnvVar(t *testing.T) {
+	c := Caser{From: ScreamingSnakeCase, To: LowerCamelCase}
+
+	name, ok := FromEnvVar(c, "MYAPP", "MYAPP_MAX_RETRIES")
+	AssertEqual(name, "maxRetries", t)
+	AssertEqual(ok, true, t)
+
+	_, ok = FromEnvVar(c, "MYAPP", "OTHER_MAX_RETRIES")
+	AssertEqual(ok, false, t)
+	_, ok = FromEnvVar(c, "MYAPP", "MYAPPLICATION")
+	AssertEqual(ok, false, t)
+}
+
+func TestEnvVarRoundTrip(t *testing.T) {
+	to := Caser{From: LowerCamelCase, To: ScreamingSnakeCase}
+	from := Caser{From: ScreamingSnakeCase, To: LowerCamelCase}
+
+	for _, name := range []string{"timeout", "maxRetries", "listenAddr2", "serverURL"} {
+		back, ok := FromEnvVar(from, "", ToEnvVar(to, name))
+		AssertEqual(ok, true, t)
+		AssertEqual(back, name, t)
+	}
+}
+
+func TestToEnvVarsCollision(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: ScreamingSnakeCase}
+
+	vars, err := ToEnvVars(c, "APP", []string{"userID", "timeout"})
+	AssertEqual(err, nil, t)
+	AssertEqual(vars, map[string]string{"userID": "APP_USER_ID", "timeout": "APP_TIMEOUT"}, t)
+
+	_, err = ToEnvVars(c, "APP", []string{"userID", "userId"})
+	AssertEqual(err != nil, true, t)
+}
@@ -0,0 +1,40 @@
+package varcaser
+
+// This file defines the tracing of conversions word by word.
+
+// A WordTrace describes how Caser.String rendered one word of a variable
+// name.
+type WordTrace struct {
+	// Source is the word as the Caser found it in the variable name, after
+	// splitting and WordMap.
+	Source string
+
+	// Result is the word as it appears in the converted name.
+	Result string
+
+	// IsAcronym reports whether Result is the word rendered as an
+	// initialism or from the acronym map, rather than cased by the To
+	// CaseConvention. Only CaseConventions that keep initialisms render
+	// words that way.
+	IsAcronym bool
+}
+
+// Explain returns how the words of s are rendered by String, in order, so
+// that "getHTTPStatus" converted to UpperCamelCase gives "get" rendered as
+// "Get", "HTTP" kept as an acronym, and "Status". Preserved separators are
+// not words and are left out.
+func (c Caser) Explain(s string) []WordTrace {
+	_, s, _ = c.separatorAffixes(s)
+	words := c.mapWords(c.words(s))
+	if len(words) == 0 {
+		return nil
+	}
+
+	acronyms := make([]bool, len(words))
+	components := c.caseWordsInto(nil, words, acronyms)
+	traces := make([]WordTrace, len(words))
+	for i, word := range words {
+		traces[i] = WordTrace{Source: word, Result: components[i], IsAcronym: acronyms[i]}
+	}
+	return traces
+}
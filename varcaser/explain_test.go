@@ -0,0 +1,27 @@
+package varcaser
+
+import "testing"
+
+func TestCaserExplain(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: UpperCamelCase}
+	AssertEqual(c.Explain("getHTTPStatus"), []WordTrace{
+		{Source: "get", Result: "Get"},
+		{Source: "HTTP", Result: "HTTP", IsAcronym: true},
+		{Source: "Status", Result: "Status"},
+	}, t)
+
+	// LowerSnakeCase doesn't keep initialisms.
+	c.To = LowerSnakeCase
+	AssertEqual(c.Explain("getHTTPStatus"), []WordTrace{
+		{Source: "get", Result: "get"},
+		{Source: "HTTP", Result: "http"},
+		{Source: "Status", Result: "status"},
+	}, t)
+
+	c = Caser{From: LowerSnakeCase, To: LowerCamelCase, PreserveLeadingSeparator: true}
+	AssertEqual(c.Explain("_user_ids"), []WordTrace{
+		{Source: "user", Result: "user"},
+		{Source: "ids", Result: "IDs", IsAcronym: true},
+	}, t)
+	AssertEqual(len(c.Explain("__")), 0, t)
+}
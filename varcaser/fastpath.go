@@ -0,0 +1,80 @@
+package varcaser
+
+// This file defines the conversions that String specializes for predefined
+// case conventions.
+
+import (
+	"reflect"
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// camelToSnake reports whether c converts from camel case to lowercase
+// snake_case without any option that changes how the words are split or
+// cased, as Caser{From: LowerCamelCase, To: LowerSnakeCase} does. Such
+// conversions are done by convertCamelToSnake instead of through the
+// functions of the conventions, which gives the same result faster.
+//
+// The conventions are recognized by their functions rather than their names,
+// so that a copy of a predefined CaseConvention with a function replaced is
+// converted the generic way.
+func (c Caser) camelToSnake() bool {
+	from, ok := c.From.(CaseConvention)
+	if !ok || from.PostSplit != nil || !sameFunc(from.Split, SplitCamel) {
+		return false
+	}
+	if c.To.KeepInitialisms || !sameFunc(c.To.Join, joinSnake) ||
+		!sameFunc(c.To.InitialCase, strings.ToLower) || !sameFunc(c.To.SubsequentCase, strings.ToLower) {
+		return false
+	}
+	return c.Digits == DigitsJoinPrevious && !c.NumbersAreSeparators &&
+		!c.LenientSplit && !c.CaseOnlySplit && !c.NormalizeUnicode &&
+		c.WordMap == nil && c.CaseRules == nil && len(c.AtomicTokens) == 0
+}
+
+// sameFunc reports whether f and g are the same function. Closures created by
+// the same function literal count as the same function, so only named
+// functions can be told apart.
+func sameFunc[F any](f, g F) bool {
+	return reflect.ValueOf(f).Pointer() == reflect.ValueOf(g).Pointer()
+}
+
+// convertCamelToSnake converts s, which has no separators that c preserves,
+// the way c.String would if c.camelToSnake(): the words of s in lowercase,
+// joined with underscores.
+func convertCamelToSnake(s string) string {
+	result := appendCamelToSnake(make([]byte, 0, len(s)+len(s)/4), s)
+	// Nothing else refers to result, as with strings.Builder.String.
+	return unsafe.String(unsafe.SliceData(result), len(result))
+}
+
+// appendCamelToSnake appends convertCamelToSnake(s) to dst.
+func appendCamelToSnake(dst []byte, s string) []byte {
+	start := len(dst)
+	eachCamelWord(s, func(word string) {
+		if len(dst) > start {
+			dst = append(dst, '_')
+		}
+		dst = appendLower(dst, word)
+	})
+	return dst
+}
+
+// appendLower appends strings.ToLower(s) to dst, without allocating if s is
+// ASCII.
+func appendLower(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return append(dst, strings.ToLower(s)...)
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		dst = append(dst, b)
+	}
+	return dst
+}
@@ -0,0 +1,110 @@
+package varcaser
+
+import (
+	"strings"
+	"testing"
+)
+
+// genericSnake is LowerSnakeCase with a Join function that the Caser doesn't
+// recognize, so that it converts to it the generic way.
+var genericSnake = LowerSnakeCase
+
+func init() {
+	genericSnake.Join = func(components []string) string {
+		return joinSnake(components)
+	}
+}
+
+// camelNames returns every concatenation of up to three of a set of
+// fragments that exercise the camel split.
+func camelNames() []string {
+	fragments := []string{
+		"", "a", "A", "id", "ID", "Id", "http", "HTTP", "Url", "URLs", "v2",
+		"2nd", "42", "x", "Server", "_", "-", " ", "__", "é", "É", "Été",
+		"ǅ", "ß", "İ", "\xff", "s", "IPv", "Value", "MD5",
+	}
+	names := []string{}
+	for _, a := range fragments {
+		for _, b := range fragments {
+			for _, c := range fragments {
+				names = append(names, a+b+c)
+			}
+		}
+	}
+	return names
+}
+
+func TestCaserCamelToSnakeSpecialized(t *testing.T) {
+	for _, c := range []Caser{
+		{From: LowerCamelCase, To: LowerSnakeCase},
+		{From: UpperCamelCase, To: LowerSnakeCase},
+		{From: FlatCase, To: LowerSnakeCase},
+		{From: LowerCamelCase, To: LowerSnakeCase, PreserveLeadingSeparator: true, PreserveTrailingSeparator: true},
+		{From: LowerCamelCase, To: LowerSnakeCase, EmptyComponents: EmptyComponentsPreserve, MinAcronymLength: 3},
+		{From: LowerCamelCase, To: LowerSnakeCase, PreserveSourceCaps: true, PreserveUnknownAllCaps: true},
+	} {
+		AssertEqual(c.camelToSnake(), true, t)
+	}
+
+	lowerCamel := LowerCamelCase
+	lowerCamel.PostSplit = func(words []string) []string { return words }
+	for _, c := range []Caser{
+		{From: LowerCamelCase, To: genericSnake},
+		{From: LowerCamelCase, To: ScreamingSnakeCase},
+		{From: LowerCamelCase, To: KebabCase},
+		{From: LowerSnakeCase, To: LowerSnakeCase},
+		{From: lowerCamel, To: LowerSnakeCase},
+		{From: LowerCamelCase, To: LowerSnakeCase, LenientSplit: true},
+		{From: LowerCamelCase, To: LowerSnakeCase, Digits: DigitsSeparate},
+		{From: LowerCamelCase, To: LowerSnakeCase, AtomicTokens: []string{"iOS"}},
+		{From: LowerCamelCase, To: LowerSnakeCase.WithWordCase(ToStrictTitle, strings.ToLower)},
+	} {
+		AssertEqual(c.camelToSnake(), false, t)
+	}
+}
+
+func TestCaserCamelToSnakeDifferential(t *testing.T) {
+	for _, options := range []Caser{
+		{},
+		{PreserveLeadingSeparator: true, PreserveTrailingSeparator: true},
+		{EmptyComponents: EmptyComponentsPreserve},
+		{PreserveSourceCaps: true, MinAcronymLength: 3},
+	} {
+		for _, from := range []CaseConvention{LowerCamelCase, UpperCamelCase} {
+			specialized := options
+			specialized.From, specialized.To = from, LowerSnakeCase
+			generic := options
+			generic.From, generic.To = from, genericSnake
+			pooled := specialized.Pooled()
+
+			for _, name := range camelNames() {
+				expected := generic.String(name)
+				if specimen := specialized.String(name); specimen != expected {
+					t.Errorf("%q: wanted %q, got %q", name, expected, specimen)
+				}
+				if specimen := specialized.Bytes([]byte(name)); string(specimen) != expected {
+					t.Errorf("%q: wanted %q from Bytes, got %q", name, expected, specimen)
+				}
+				if specimen := pooled.String(name); specimen != expected {
+					t.Errorf("%q: wanted %q from a PooledCaser, got %q", name, expected, specimen)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkCaserCamelToSnake(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.String("getHTTPResponseCodeForUserID")
+	}
+}
+
+func BenchmarkCaserCamelToSnakeGeneric(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: genericSnake}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.String("getHTTPResponseCodeForUserID")
+	}
+}
@@ -0,0 +1,26 @@
+package varcaser
+
+// This file defines helpers for command-line flag names.
+
+import "strings"
+
+// FlagName returns the command-line flag name for a struct field name, such
+// as "max-retry-count" for "MaxRetryCount", without the leading dashes. The
+// zero Caser converts from UpperCamelCase to KebabCase.
+func FlagName(c Caser, field string) string {
+	if c.From == nil {
+		c = Caser{From: UpperCamelCase, To: KebabCase}
+	}
+	return c.String(field)
+}
+
+// FieldFromFlag returns the struct field name for a command-line flag name,
+// given with or without its leading dashes. The zero Caser converts from
+// KebabCase to UpperCamelCase, so known initialisms come back in uppercase and
+// "--http-port" maps to "HTTPPort".
+func FieldFromFlag(c Caser, flag string) string {
+	if c.From == nil {
+		c = Caser{From: KebabCase, To: UpperCamelCase}
+	}
+	return c.String(strings.TrimLeft(flag, "-"))
+}
@@ -0,0 +1,21 @@
+package varcaser
+
+import "testing"
+
+func TestFlagName(t *testing.T) {
+	AssertEqual(FlagName(Caser{}, "MaxRetryCount"), "max-retry-count", t)
+	AssertEqual(FlagName(Caser{}, "HTTPPort"), "http-port", t)
+	AssertEqual(FlagName(Caser{From: UpperCamelCase, To: LowerSnakeCase}, "HTTPPort"), "http_port", t)
+}
+
+func TestFieldFromFlag(t *testing.T) {
+	AssertEqual(FieldFromFlag(Caser{}, "--max-retry-count"), "MaxRetryCount", t)
+	AssertEqual(FieldFromFlag(Caser{}, "http-port"), "HTTPPort", t)
+	AssertEqual(FieldFromFlag(Caser{From: KebabCase, To: LowerCamelCase}, "-http-port"), "httpPort", t)
+}
+
+func TestFlagNameRoundTrip(t *testing.T) {
+	for _, field := range []string{"HTTPPort", "MaxRetryCount", "UserID", "APIKey", "TLSCertFile"} {
+		AssertEqual(FieldFromFlag(Caser{}, "--"+FlagName(Caser{}, field)), field, t)
+	}
+}
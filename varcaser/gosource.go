@@ -0,0 +1,324 @@
+package varcaser
+
+// This file defines the renaming of identifiers in Go source code, and the
+// conversion of struct field names from go/types.
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+)
+
+// RewriteGoSource renames the exported identifiers that the Go source file
+// src declares, converting them with c, and returns the rewritten source.
+// References to a renamed identifier within the file, such as selectors of a
+// renamed struct field, are renamed with it. Only a parse error is returned;
+// the file need not type check, though references that can't be resolved
+// without the rest of its package are left as they are.
+//
+// Methods are left as they are, since they may implement interfaces declared
+// elsewhere, and so are embedded fields, which are named after their types.
+// Identifiers whose new names would not be valid, such as keywords, are kept
+// too, and so are identifiers whose new names would clash with another name
+// in their scope or struct, or would change what a reference resolves to.
+func (c Caser) RewriteGoSource(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	info := checkGoFile(fset, file)
+	renames := c.goRenames(info)
+	for {
+		renameGoIdents(info, renames)
+		broken := brokenGoRenames(info, checkGoFile(fset, file))
+		deleted := false
+		for obj := range broken {
+			if _, ok := renames[obj]; ok {
+				delete(renames, obj)
+				deleted = true
+			}
+		}
+		if !deleted {
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	config := printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 8}
+	if err := config.Fprint(&buf, fset, file); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// goFile is the type information of a Go source file.
+type goFile struct {
+	pkg *types.Package
+	*types.Info
+}
+
+// checkGoFile type checks file, ignoring any errors.
+func checkGoFile(fset *token.FileSet, file *ast.File) goFile {
+	info := &types.Info{
+		Types: map[ast.Expr]types.TypeAndValue{},
+		Defs:  map[*ast.Ident]types.Object{},
+		Uses:  map[*ast.Ident]types.Object{},
+	}
+	conf := types.Config{Importer: emptyImporter{}, Error: func(error) {}}
+	pkg, _ := conf.Check(file.Name.Name, fset, []*ast.File{file}, info)
+	return goFile{pkg, info}
+}
+
+// goRenames returns the new names of the objects that RewriteGoSource
+// renames, before checking that the renamed file resolves the same way.
+func (c Caser) goRenames(info goFile) map[types.Object]string {
+	defs := []*ast.Ident{}
+	for ident, obj := range info.Defs {
+		if obj != nil {
+			defs = append(defs, ident)
+		}
+	}
+	// Objects claim their new names in the order they are declared, so that
+	// which of two clashing objects is renamed doesn't depend on map order.
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Pos() < defs[j].Pos() })
+
+	uses := map[types.Object][]*ast.Ident{}
+	for ident, obj := range info.Uses {
+		uses[obj] = append(uses[obj], ident)
+	}
+
+	// The struct types that declare each field, and the named types that
+	// have each struct type, whose methods field names must not clash with.
+	structs := map[*types.Var]*types.Struct{}
+	named := map[*types.Struct][]types.Type{}
+	for _, tv := range info.Types {
+		if t, ok := tv.Type.(*types.Struct); ok {
+			for i := 0; i < t.NumFields(); i++ {
+				structs[t.Field(i)] = t
+			}
+		}
+	}
+	for _, ident := range defs {
+		if obj, ok := info.Defs[ident].(*types.TypeName); ok {
+			if t, ok := obj.Type().Underlying().(*types.Struct); ok {
+				named[t] = append(named[t], obj.Type())
+			}
+		}
+	}
+
+	renames := map[types.Object]string{}
+	claimed := map[interface{}]map[string]bool{}
+	for _, ident := range defs {
+		obj := info.Defs[ident]
+		name, ok := c.goRename(info.pkg, obj)
+		if !ok {
+			continue
+		}
+
+		var owner interface{}
+		if scope := obj.Parent(); scope != nil {
+			if !scopeFree(info.pkg, scope, name, uses[obj], claimed) {
+				continue
+			}
+			owner = scope
+		} else if t := structs[obj.(*types.Var)]; t != nil {
+			if !fieldFree(info.pkg, append([]types.Type{t}, named[t]...), name) || claimed[t][name] {
+				continue
+			}
+			owner = t
+		} else {
+			continue
+		}
+
+		if claimed[owner] == nil {
+			claimed[owner] = map[string]bool{}
+		}
+		claimed[owner][name] = true
+		renames[obj] = name
+	}
+	return renames
+}
+
+// goRename returns the name that RewriteGoSource would give obj, if it may
+// rename obj at all.
+func (c Caser) goRename(pkg *types.Package, obj types.Object) (string, bool) {
+	if obj.Pkg() != pkg || !obj.Exported() {
+		return "", false
+	}
+	switch obj := obj.(type) {
+	case *types.Func:
+		if obj.Type().(*types.Signature).Recv() != nil {
+			return "", false
+		}
+	case *types.Var:
+		if obj.Embedded() {
+			return "", false
+		}
+	case *types.Label:
+		return "", false
+	}
+
+	name := c.String(obj.Name())
+	if name == obj.Name() || !token.IsIdentifier(name) {
+		return "", false
+	}
+	return name, true
+}
+
+// scopeFree reports whether an object declared in scope may be renamed to
+// name: name is neither declared nor claimed in scope, its ancestors or, for
+// the package scope, its files, and at each of uses, the references to the
+// object, name doesn't resolve to anything else.
+func scopeFree(pkg *types.Package, scope *types.Scope, name string, uses []*ast.Ident, claimed map[interface{}]map[string]bool) bool {
+	for s := scope; s != nil; s = s.Parent() {
+		if s.Lookup(name) != nil || claimed[s][name] {
+			return false
+		}
+	}
+	if scope == pkg.Scope() {
+		for i := 0; i < scope.NumChildren(); i++ {
+			if file := scope.Child(i); file.Lookup(name) != nil || claimed[file][name] {
+				return false
+			}
+		}
+	}
+
+	for _, ident := range uses {
+		inner := pkg.Scope().Innermost(ident.Pos())
+		if inner == nil {
+			return false
+		}
+		if _, obj := inner.LookupParent(name, ident.Pos()); obj != nil {
+			return false
+		}
+		for s := inner; s != scope && s != nil; s = s.Parent() {
+			if claimed[s][name] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// fieldFree reports whether none of ts, a struct type and the named types
+// that have it, has a field or method called name.
+func fieldFree(pkg *types.Package, ts []types.Type, name string) bool {
+	for _, t := range ts {
+		if obj, index, _ := types.LookupFieldOrMethod(t, true, pkg, name); obj != nil || index != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// renameGoIdents names the identifiers that info resolves after the objects
+// they refer to, renamed by renames.
+func renameGoIdents(info goFile, renames map[types.Object]string) {
+	for _, idents := range []map[*ast.Ident]types.Object{info.Defs, info.Uses} {
+		for ident, obj := range idents {
+			if obj == nil {
+				continue
+			}
+			if name, ok := renames[obj]; ok {
+				ident.Name = name
+			} else {
+				ident.Name = obj.Name()
+			}
+		}
+	}
+}
+
+// brokenGoRenames returns the objects of before, the type information of a
+// file before renaming, whose identifiers resolve differently in after, the
+// type information of the renamed file. Fields promoted from embedded
+// structs, for one, can be shadowed in ways that the checks of goRenames
+// don't foresee.
+func brokenGoRenames(before, after goFile) map[types.Object]bool {
+	declared := map[token.Pos]types.Object{}
+	for _, obj := range before.Defs {
+		if obj != nil {
+			declared[obj.Pos()] = obj
+		}
+	}
+	same := func(a, b types.Object) bool {
+		return a == b || a.Pos().IsValid() && a.Pos() == b.Pos()
+	}
+
+	broken := map[types.Object]bool{}
+	for i, idents := range []map[*ast.Ident]types.Object{before.Defs, before.Uses} {
+		// The identifier of an embedded field is in both Defs and Uses.
+		resolved := []map[*ast.Ident]types.Object{after.Defs, after.Uses}[i]
+		for ident, obj := range idents {
+			if obj == nil {
+				continue
+			}
+			if renamed := resolved[ident]; renamed == nil || !same(obj, renamed) {
+				broken[obj] = true
+				if renamed != nil {
+					broken[declared[renamed.Pos()]] = true
+				}
+			}
+		}
+	}
+	for ident, obj := range after.Uses {
+		if _, ok := before.Uses[ident]; !ok {
+			broken[declared[obj.Pos()]] = true
+		}
+	}
+	return broken
+}
+
+// FieldNames returns the names of the fields of t converted with c, in
+// declaration order, for code generators that work from type information
+// rather than from values like RetagStruct. Like RetagStruct, it leaves out
+// unexported and blank fields, and includes the fields of embedded structs,
+// or pointers to them, in place of the embedded field. A struct that embeds
+// itself, directly or not, contributes its fields once.
+func FieldNames(c Caser, t *types.Struct) []string {
+	return appendFieldNames([]string{}, c, t, map[*types.Struct]bool{})
+}
+
+// appendFieldNames appends the names that FieldNames returns for t to names,
+// unless t has been visited.
+func appendFieldNames(names []string, c Caser, t *types.Struct, visited map[*types.Struct]bool) []string {
+	if visited[t] {
+		return names
+	}
+	visited[t] = true
+
+	for i := 0; i < t.NumFields(); i++ {
+		field := t.Field(i)
+		if field.Embedded() {
+			embedded := field.Type()
+			if pointer, ok := embedded.(*types.Pointer); ok {
+				embedded = pointer.Elem()
+			}
+			if embedded, ok := embedded.Underlying().(*types.Struct); ok {
+				names = appendFieldNames(names, c, embedded, visited)
+				continue
+			}
+		}
+		if !field.Exported() {
+			continue
+		}
+		names = append(names, c.String(field.Name()))
+	}
+	return names
+}
+
+// emptyImporter imports every package as an empty one, so that
+// RewriteGoSource can type check a file without its dependencies.
+type emptyImporter struct{}
+
+func (emptyImporter) Import(importPath string) (*types.Package, error) {
+	pkg := types.NewPackage(importPath, path.Base(importPath))
+	pkg.MarkComplete()
+	return pkg, nil
+}
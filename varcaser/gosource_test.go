@@ -0,0 +1,141 @@
+package varcaser
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestCaserRewriteGoSource(t *testing.T) {
+	src := `package user
+
+import "fmt"
+
+type Profile struct {
+	FirstName string
+	LastName  string
+	fmt.Stringer
+}
+
+func (p Profile) String() string {
+	return p.FirstName + " " + p.LastName
+}
+
+func NewProfile(first, last string) Profile {
+	return Profile{FirstName: first, LastName: last}
+}
+
+func greet(p *Profile) string {
+	return fmt.Sprintf("Hello, %s!", p.FirstName)
+}
+`
+	want := `package user
+
+import "fmt"
+
+type profile struct {
+	first_name string
+	last_name  string
+	fmt.Stringer
+}
+
+func (p profile) String() string {
+	return p.first_name + " " + p.last_name
+}
+
+func new_profile(first, last string) profile {
+	return profile{first_name: first, last_name: last}
+}
+
+func greet(p *profile) string {
+	return fmt.Sprintf("Hello, %s!", p.first_name)
+}
+`
+	c := Caser{From: UpperCamelCase, To: LowerSnakeCase}
+	result, err := c.RewriteGoSource([]byte(src))
+	AssertEqual(err, nil, t)
+	AssertEqual(string(result), want, t)
+}
+
+func TestCaserRewriteGoSourceKeeps(t *testing.T) {
+	src := `package p
+
+const Type = 1
+
+var Name, name = "a", "b"
+`
+	c := Caser{From: UpperCamelCase, To: LowerSnakeCase}
+	result, err := c.RewriteGoSource([]byte(src))
+	AssertEqual(err, nil, t)
+	AssertEqual(string(result), src, t)
+
+	_, err = c.RewriteGoSource([]byte("package p\nfunc {"))
+	AssertEqual(err != nil, true, t)
+	AssertEqual(strings.Contains(err.Error(), "expected"), true, t)
+}
+
+func TestCaserRewriteGoSourceScopes(t *testing.T) {
+	c := Caser{From: UpperCamelCase, To: LowerSnakeCase}
+	for _, test := range []struct{ src, want string }{
+		// Renaming Count would make f refer to its local variable.
+		{"var Count = 1\n\nfunc f() int {\n\tcount := 2\n\treturn Count + count\n}\n", ""},
+		// Only the first of two names that convert alike is renamed.
+		{"const UserID = 1\nconst UserId = 2\n", "const user_id = 1\nconst UserId = 2\n"},
+		{"type T struct {\n\tUserID int\n\tUserId int\n}\n", "type t struct {\n\tuser_id int\n\tUserId  int\n}\n"},
+		// A field would clash with a method.
+		{"type T struct{ Size int }\n\nfunc (T) size() int { return 0 }\n", "type t struct{ Size int }\n\nfunc (t) size() int { return 0 }\n"},
+		// Renaming ID would make t.ID refer to the outer field.
+		{"type Base struct{ ID int }\n\ntype t struct {\n\tBase\n\tid int\n}\n\nvar _ = t{}.ID\n", "type base struct{ ID int }\n\ntype t struct {\n\tbase\n\tid int\n}\n\nvar _ = t{}.ID\n"},
+		// Renaming Len would make the builtin call refer to it.
+		{"var Len = 1\nvar _ = len(\"\")\n", ""},
+	} {
+		src := "package p\n\n" + test.src
+		want := src
+		if test.want != "" {
+			want = "package p\n\n" + test.want
+		}
+		result, err := c.RewriteGoSource([]byte(src))
+		AssertEqual(err, nil, t)
+		AssertEqual(string(result), want, t)
+	}
+}
+
+func TestFieldNames(t *testing.T) {
+	src := `package p
+
+type Base struct {
+	CreatedAt int
+	internal  int
+}
+
+type User struct {
+	UserID    int
+	FirstName string
+	_         int
+	secret    string
+	*Base
+	HTTPProxyURL string
+}
+
+type Node struct {
+	*Node
+	NodeName string
+}
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "p.go", src, 0)
+	AssertEqual(err, nil, t)
+	pkg, err := (&types.Config{}).Check("p", fset, []*ast.File{file}, nil)
+	AssertEqual(err, nil, t)
+	user := pkg.Scope().Lookup("User").Type().Underlying().(*types.Struct)
+
+	c := Caser{From: UpperCamelCase, To: LowerSnakeCase}
+	AssertEqual(FieldNames(c, user), []string{"user_id", "first_name", "created_at", "http_proxy_url"}, t)
+	AssertEqual(FieldNames(c, types.NewStruct(nil, nil)), []string{}, t)
+
+	node := pkg.Scope().Lookup("Node").Type().Underlying().(*types.Struct)
+	AssertEqual(FieldNames(c, node), []string{"node_name"}, t)
+}
@@ -0,0 +1,19 @@
+package varcaser
+
+// This file defines helpers for GraphQL schemas.
+
+import "strings"
+
+// GraphQLFieldName returns the GraphQL field name for a variable name, such as
+// "userName" for "user_name". Names starting with "__", which GraphQL reserves
+// for introspection fields such as "__typename", are returned unchanged. The
+// zero Caser converts from LowerSnakeCase to LowerCamelCase.
+func GraphQLFieldName(c Caser, name string) string {
+	if strings.HasPrefix(name, "__") {
+		return name
+	}
+	if c.From == nil {
+		c = Caser{From: LowerSnakeCase, To: LowerCamelCase}
+	}
+	return c.String(name)
+}
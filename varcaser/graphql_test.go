@@ -0,0 +1,15 @@
+package varcaser
+
+import "testing"
+
+func TestGraphQLFieldName(t *testing.T) {
+	AssertEqual(GraphQLFieldName(Caser{}, "user_name"), "userName", t)
+	AssertEqual(GraphQLFieldName(Caser{}, "user_id"), "userID", t)
+	AssertEqual(GraphQLFieldName(Caser{}, "__typename"), "__typename", t)
+	AssertEqual(GraphQLFieldName(Caser{}, "__schema"), "__schema", t)
+	AssertEqual(GraphQLFieldName(Caser{}, "__Type_kind"), "__Type_kind", t)
+
+	fromGo := Caser{From: UpperCamelCase, To: LowerCamelCase}
+	AssertEqual(GraphQLFieldName(fromGo, "CreatedAt"), "createdAt", t)
+	AssertEqual(GraphQLFieldName(fromGo, "__typename"), "__typename", t)
+}
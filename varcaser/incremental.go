@@ -0,0 +1,81 @@
+package varcaser
+
+// This file defines a camel case splitter that is fed one rune at a time.
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// An IncrementalSplitter splits a camelCase or PascalCase variable name into
+// its words as its runes are fed to it, giving the same words as SplitCamel
+// does for the whole name. The zero IncrementalSplitter is ready to use.
+type IncrementalSplitter struct {
+	// word is the current word, and last is the offset in it of its last
+	// rune, previous.
+	word     []byte
+	last     int
+	previous rune
+
+	// inLowerRun reports whether the last letter was not uppercase.
+	inLowerRun bool
+
+	// pendingAt, when positive, is where the current word is cut if the
+	// next rune shows that an "s" or "v" after an acronym, as in "IDs" or
+	// "APIv2", didn't belong with it.
+	pendingAt int
+}
+
+// Feed adds the next rune of the variable name, and returns the word that it
+// completed, if any.
+func (sp *IncrementalSplitter) Feed(r rune) (word string, emitted bool) {
+	if sp.pendingAt > 0 {
+		if unicode.IsLower(r) {
+			word, emitted = sp.cut(sp.pendingAt), true
+		}
+		sp.pendingAt = 0
+	}
+
+	switch {
+	case unicode.IsDigit(r), !unicode.IsLetter(r):
+		// Digits and separators continue the current word, as in
+		// SplitCamel.
+
+	case !sp.inLowerRun && !unicode.IsUpper(r):
+		// The last letter of an uppercase word starts this one,
+		// unless the uppercase word is an acronym that this "s" or
+		// "v" may belong with, which the next rune decides.
+		if sp.last > 0 && unicode.IsUpper(sp.previous) {
+			if isAcronymSuffix(r) && isAllCaps(string(sp.word)) {
+				sp.pendingAt = sp.last
+			} else {
+				word, emitted = sp.cut(sp.last), true
+			}
+		}
+		sp.inLowerRun = true
+
+	case sp.inLowerRun && unicode.IsUpper(r):
+		word, emitted = sp.cut(len(sp.word)), true
+		sp.inLowerRun = false
+	}
+
+	sp.last, sp.previous = len(sp.word), r
+	sp.word = utf8.AppendRune(sp.word, r)
+	return word, emitted
+}
+
+// Flush returns the last word of the variable name, which is "" if no runes
+// have been fed since the last Flush, and resets sp for the next name.
+func (sp *IncrementalSplitter) Flush() string {
+	word := string(sp.word)
+	*sp = IncrementalSplitter{word: sp.word[:0]}
+	return word
+}
+
+// cut removes the first n bytes of the current word and returns them.
+func (sp *IncrementalSplitter) cut(n int) string {
+	word := string(sp.word[:n])
+	sp.word = append(sp.word[:0], sp.word[n:]...)
+	sp.last -= n
+	return word
+}
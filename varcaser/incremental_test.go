@@ -0,0 +1,62 @@
+package varcaser
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+// splitIncrementally splits s by feeding its runes to an IncrementalSplitter.
+func splitIncrementally(sp *IncrementalSplitter, s string) []string {
+	var words []string
+	for _, r := range s {
+		if word, ok := sp.Feed(r); ok {
+			words = append(words, word)
+		}
+	}
+	if word := sp.Flush(); word != "" {
+		words = append(words, word)
+	}
+	return words
+}
+
+func TestIncrementalSplitter(t *testing.T) {
+	var sp IncrementalSplitter
+	for _, s := range []string{
+		"", "a", "A", "aB", "userA", "someInitMethod", "AsyncHTTPRequest",
+		"XMLHttpRequest", "innerHTML", "parseJSONData", "HTML5Parser",
+		"readUTF8Buffer", "MD5hash", "userIDs", "IDs", "URLsForIDs",
+		"IDsecret", "IDsX", "idsValue", "FOO_BAR", "foo_Bar", "ÉtéÀParis",
+		"getHTTPStatus", "A1B2c3", "x​Y", "decodeJWTs", "IPv4Address",
+		"getAPIv2Client", "APIvX", "APIv", "IDvalue", "apiV2",
+	} {
+		AssertEqual(splitIncrementally(&sp, s), SplitCamel(s), t)
+	}
+}
+
+func TestIncrementalSplitterFeed(t *testing.T) {
+	var sp IncrementalSplitter
+	var words []string
+	for _, r := range "getHTTPStatus" {
+		if word, ok := sp.Feed(r); ok {
+			words = append(words, word)
+		}
+	}
+	AssertEqual(words, []string{"get", "HTTP"}, t)
+	AssertEqual(sp.Flush(), "Status", t)
+	AssertEqual(sp.Flush(), "", t)
+}
+
+func FuzzIncrementalSplitter(f *testing.F) {
+	for _, seed := range []string{"userIDs", "XMLHttpRequest", "IDsX", "FOO_BAR", "ǅungla"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if !utf8.ValidString(s) {
+			// Invalid bytes are fed as utf8.RuneError.
+			return
+		}
+		var sp IncrementalSplitter
+		AssertEqual(splitIncrementally(&sp, s), SplitCamel(s), t)
+	})
+}
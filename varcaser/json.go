@@ -0,0 +1,95 @@
+package varcaser
+
+// This file defines the conversion of the keys of JSON documents.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// ErrKeyCollision is returned when two keys of a JSON object convert to the
+// same key, so that one of their values would be lost.
+var ErrKeyCollision = fmt.Errorf("Keys convert to the same key.")
+
+// ErrTrailingData is returned when a JSON document is followed by more data.
+var ErrTrailingData = fmt.Errorf("Data after the JSON document.")
+
+// TransformJSONKeys converts every object key in the JSON document data with
+// c, including the keys of objects nested in other objects or in arrays.
+// Values are left unchanged, and numbers keep their original representation.
+// Since the document is decoded and encoded again, the keys of each object
+// come out sorted and insignificant whitespace is dropped. If two keys of an
+// object convert to the same key, an error wrapping ErrKeyCollision is
+// returned. data must hold a single JSON value, optionally surrounded by
+// whitespace.
+func (c Caser) TransformJSONKeys(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	var trailing interface{}
+	if err := decoder.Decode(&trailing); err != io.EOF {
+		if err == nil {
+			err = ErrTrailingData
+		}
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	transformed, err := c.transformJSONValue(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := encoder.Encode(transformed); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// transformJSONValue converts the object keys in a decoded JSON value.
+func (c Caser) transformJSONValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		// The keys are converted in order so that the error for a
+		// collision doesn't depend on map order.
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		transformed := make(map[string]interface{}, len(v))
+		sources := make(map[string]string, len(v))
+		for _, key := range keys {
+			converted := c.String(key)
+			if source, ok := sources[converted]; ok {
+				return nil, fmt.Errorf("%w %q and %q both convert to %q", ErrKeyCollision, source, key, converted)
+			}
+			value, err := c.transformJSONValue(v[key])
+			if err != nil {
+				return nil, err
+			}
+			transformed[converted] = value
+			sources[converted] = key
+		}
+		return transformed, nil
+	case []interface{}:
+		transformed := make([]interface{}, len(v))
+		for i, value := range v {
+			var err error
+			if transformed[i], err = c.transformJSONValue(value); err != nil {
+				return nil, err
+			}
+		}
+		return transformed, nil
+	}
+	return v, nil
+}
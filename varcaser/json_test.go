@@ -0,0 +1,61 @@
+package varcaser
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTransformJSONKeysNested(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+
+	specimen, err := c.TransformJSONKeys([]byte(`{"userId": 12345678901234567890, "homeAddress": {"streetName": "Main <St>", "zipCode": null}}`))
+	expected := `{"home_address":{"street_name":"Main <St>","zip_code":null},"user_id":12345678901234567890}`
+	AssertEqual(err, nil, t)
+	AssertEqual(string(specimen), expected, t)
+}
+
+func TestTransformJSONKeysArrays(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+
+	specimen, err := c.TransformJSONKeys([]byte(`{"line_items": [{"item_name": "a", "unit_price": 1.50}, {"item_name": "b", "tags": ["keep_me", true]}]}`))
+	expected := `{"lineItems":[{"itemName":"a","unitPrice":1.50},{"itemName":"b","tags":["keep_me",true]}]}`
+	AssertEqual(err, nil, t)
+	AssertEqual(string(specimen), expected, t)
+}
+
+func TestTransformJSONKeysNonObject(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+
+	for _, data := range []string{`"some_string"`, `42`, `null`, `[1,"two_words",false]`} {
+		specimen, err := c.TransformJSONKeys([]byte(data))
+		AssertEqual(err, nil, t)
+		AssertEqual(string(specimen), data, t)
+	}
+}
+
+func TestTransformJSONKeysInvalid(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+
+	_, err := c.TransformJSONKeys([]byte(`{"unterminated": `))
+	AssertEqual(err != nil, true, t)
+
+	_, err = c.TransformJSONKeys([]byte(`{"a": 1} trailing garbage`))
+	AssertEqual(err != nil, true, t)
+
+	_, err = c.TransformJSONKeys([]byte(`{"a": 1} {"b": 2}`))
+	AssertEqual(err, ErrTrailingData, t)
+
+	specimen, err := c.TransformJSONKeys([]byte(" {\"a_b\": 1}\n"))
+	AssertEqual(err, nil, t)
+	AssertEqual(string(specimen), `{"aB":1}`, t)
+}
+
+func TestTransformJSONKeysCollision(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+
+	for _, data := range []string{`{"userId": 1, "user_id": 2}`, `[{"nested": {"user_id": 1, "userId": 2}}]`} {
+		_, err := c.TransformJSONKeys([]byte(data))
+		AssertEqual(errors.Is(err, ErrKeyCollision), true, t)
+		AssertEqual(err.Error(), `Keys convert to the same key. "userId" and "user_id" both convert to "user_id"`, t)
+	}
+}
@@ -0,0 +1,26 @@
+package varcaser
+
+// This file defines the Name type.
+
+// A Name is a variable name that is converted with its Caser whenever it is
+// marshaled as text, for example by encoding/json, so a struct field can carry
+// a name that comes out in the configured convention.
+type Name struct {
+	Value string
+	Caser Caser
+}
+
+// MarshalText returns Value converted with Caser, or Value unchanged if Caser
+// is the zero Caser.
+func (n Name) MarshalText() ([]byte, error) {
+	if n.Caser.From == nil {
+		return []byte(n.Value), nil
+	}
+	return []byte(n.Caser.String(n.Value)), nil
+}
+
+// UnmarshalText stores text as Value as it is, without converting it.
+func (n *Name) UnmarshalText(text []byte) error {
+	n.Value = string(text)
+	return nil
+}
@@ -0,0 +1,33 @@
+package varcaser
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNameMarshalText(t *testing.T) {
+	type column struct {
+		Column Name `json:"column"`
+	}
+
+	snake, err := json.Marshal(column{Name{"userID", Caser{From: LowerCamelCase, To: LowerSnakeCase}}})
+	AssertEqual(err, nil, t)
+	AssertEqual(string(snake), `{"column":"user_id"}`, t)
+
+	kebab, err := json.Marshal(column{Name{"userID", Caser{From: LowerCamelCase, To: ScreamingKebabCase}}})
+	AssertEqual(err, nil, t)
+	AssertEqual(string(kebab), `{"column":"USER-ID"}`, t)
+}
+
+func TestNameZeroCaser(t *testing.T) {
+	text, err := Name{Value: "userID"}.MarshalText()
+	AssertEqual(err, nil, t)
+	AssertEqual(string(text), "userID", t)
+}
+
+func TestNameUnmarshalText(t *testing.T) {
+	n := Name{Caser: Caser{From: LowerCamelCase, To: LowerSnakeCase}}
+
+	AssertEqual(json.Unmarshal([]byte(`"userID"`), &n), nil, t)
+	AssertEqual(n.Value, "userID", t)
+}
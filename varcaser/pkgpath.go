@@ -0,0 +1,60 @@
+package varcaser
+
+// This file defines import aliases for Go package paths.
+
+import (
+	"go/token"
+	"strings"
+)
+
+// PackageAlias returns an identifier for the package with the given import
+// path, converted with c from its last path segment, so that
+// "github.com/foo/my-cool-pkg" becomes "myCoolPkg". Major version segments
+// such as "/v2", and suffixes such as the ".v3" of "gopkg.in/yaml.v3", are
+// skipped, as are trailing slashes. Other dots in the segment are replaced by
+// hyphens. The zero Caser converts from KebabCase, leniently, to
+// LowerCamelCase.
+//
+// The result is always a valid identifier. Runes that can't be part of one are
+// dropped, and aliases that would start with a digit, be a keyword or be
+// empty, such as the alias of "github.com/foo/3d-lib", are converted with a
+// "pkg" word in front, as "pkg3dLib".
+func PackageAlias(c Caser, importPath string) string {
+	segments := strings.Split(strings.TrimRight(importPath, "/"), "/")
+	segment := segments[len(segments)-1]
+	if len(segments) > 1 && isMajorVersion(segment) {
+		segment = segments[len(segments)-2]
+	}
+	if i := strings.LastIndexByte(segment, '.'); i >= 0 && isMajorVersion(segment[i+1:]) {
+		segment = segment[:i]
+	}
+
+	if c.From == nil {
+		c = Caser{From: KebabCase, To: LowerCamelCase, LenientSplit: true}
+	}
+	name := strings.ReplaceAll(segment, ".", "-")
+	alias := identifierRunes(c.String(name))
+	if !token.IsIdentifier(alias) {
+		alias = identifierRunes(c.String("pkg-" + name))
+	}
+	return alias
+}
+
+// identifierRunes returns s without the runes that can't be part of an
+// identifier.
+func identifierRunes(s string) string {
+	return strings.Map(keepRunes(isIdentifierRune), s)
+}
+
+// isMajorVersion reports whether s is a major version, such as "v2".
+func isMajorVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
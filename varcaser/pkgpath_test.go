@@ -0,0 +1,32 @@
+package varcaser
+
+import (
+	"go/token"
+	"testing"
+)
+
+func TestPackageAlias(t *testing.T) {
+	AssertEqual(PackageAlias(Caser{}, "github.com/foo/my-cool-pkg"), "myCoolPkg", t)
+	AssertEqual(PackageAlias(Caser{}, "github.com/foo/my-cool-pkg/"), "myCoolPkg", t)
+	AssertEqual(PackageAlias(Caser{}, "github.com/foo/my-cool-pkg/v2"), "myCoolPkg", t)
+	AssertEqual(PackageAlias(Caser{}, "github.com/foo/my-cool-pkg/v12/"), "myCoolPkg", t)
+	AssertEqual(PackageAlias(Caser{}, "gopkg.in/yaml.v3"), "yaml", t)
+	AssertEqual(PackageAlias(Caser{}, "github.com/satori/go.uuid"), "goUUID", t)
+	AssertEqual(PackageAlias(Caser{}, "github.com/foo/http_client"), "httpClient", t)
+	AssertEqual(PackageAlias(Caser{}, "fmt"), "fmt", t)
+	AssertEqual(PackageAlias(Caser{}, "v2"), "v2", t)
+
+	// Invalid identifiers are fixed.
+	AssertEqual(PackageAlias(Caser{}, "github.com/foo/3d-lib"), "pkg3dLib", t)
+	AssertEqual(PackageAlias(Caser{}, "github.com/foo/go"), "pkgGo", t)
+	AssertEqual(PackageAlias(Caser{}, "github.com/foo/c++"), "c", t)
+	AssertEqual(PackageAlias(Caser{}, "github.com/foo/++"), "pkg", t)
+	AssertEqual(PackageAlias(Caser{}, ""), "pkg", t)
+	for _, importPath := range []string{"github.com/foo/3d-lib", "github.com/foo/type", "github.com/foo/v2/42", "gopkg.in/1.v1"} {
+		AssertEqual(token.IsIdentifier(PackageAlias(Caser{}, importPath)), true, t)
+	}
+
+	upper := Caser{From: KebabCase, To: UpperCamelCase}
+	AssertEqual(PackageAlias(upper, "github.com/foo/json-api/v2"), "JSONAPI", t)
+	AssertEqual(PackageAlias(upper, "github.com/foo/3d-lib"), "Pkg3dLib", t)
+}
@@ -0,0 +1,39 @@
+package varcaser
+
+// This file defines the PooledCaser type.
+
+import "sync"
+
+// A PooledCaser converts variable names like a Caser, but renders words into
+// slices that it reuses across conversions and goroutines, instead of
+// allocating one for every conversion. It is safe for concurrent use, and
+// pays off for servers converting many names concurrently.
+//
+// It pools the slices of rendered words rather than strings.Builders: the
+// string a Builder returns shares its buffer, so a Builder can only be reused
+// after a Reset that drops the buffer, which saves nothing.
+//
+// The slices are passed to the Join function of the To CaseConvention, which
+// must not keep them; the predefined ones don't.
+type PooledCaser struct {
+	caser  Caser
+	slices sync.Pool // of *[]string
+}
+
+// Pooled returns a PooledCaser that converts names with c.
+func (c Caser) Pooled() *PooledCaser {
+	return &PooledCaser{caser: c}
+}
+
+// String returns the result of the underlying Caser's String for s.
+func (p *PooledCaser) String(s string) string {
+	scratch, _ := p.slices.Get().(*[]string)
+	if scratch == nil {
+		scratch = new([]string)
+	}
+	result, components := p.caser.convert(s, *scratch)
+	clear(components)
+	*scratch = components
+	p.slices.Put(scratch)
+	return result
+}
@@ -0,0 +1,83 @@
+package varcaser
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestPooledCaser(t *testing.T) {
+	plain := Caser{From: LowerCamelCase, To: LowerSnakeCase, PreserveLeadingSeparator: true}
+	c := plain.Pooled()
+
+	for _, s := range []string{"userID", "_privateValue", "count", "", "someLongerVariableName", "x"} {
+		AssertEqual(c.String(s), plain.String(s), t)
+	}
+}
+
+func TestPooledCaserConcurrent(t *testing.T) {
+	plain := Caser{From: LowerCamelCase, To: KebabCase}
+	c := plain.Pooled()
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 64)
+	for g := 0; g < 32; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				name := fmt.Sprintf("some%dVariable%dName", g, i%7)
+				if specimen, expected := c.String(name), plain.String(name); specimen != expected {
+					errs <- fmt.Sprintf("Wanted %v, got %v", expected, specimen)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func BenchmarkCaserParallel(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.String("someLongerVariableName")
+		}
+	})
+}
+
+func BenchmarkPooledCaserParallel(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}.Pooled()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.String("someLongerVariableName")
+		}
+	})
+}
+
+func BenchmarkCaserParallelGeneric(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: KebabCase}
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.String("someLongerVariableName")
+		}
+	})
+}
+
+func BenchmarkPooledCaserParallelGeneric(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: KebabCase}.Pooled()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.String("someLongerVariableName")
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package varcaser
+
+// This file defines the names of protobuf fields and methods.
+
+import "strings"
+
+// ProtoJSONName returns the JSON name that protobuf gives a field, such as
+// "fooBar" for "foo_bar". It follows protoc exactly: underscores are removed,
+// and a lowercase ASCII letter following one is uppercased. Everything else,
+// including digits and the case of the first letter, is left as it is, so
+// "foo_123_bar" becomes "foo123Bar" and "__double__" becomes "Double".
+func ProtoJSONName(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	wasUnderscore := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '_' {
+			if wasUnderscore && 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			b.WriteByte(c)
+		}
+		wasUnderscore = c == '_'
+	}
+	return b.String()
+}
+
+// protoJSONCase renders words as ProtoJSONName renders them joined with
+// underscores.
+var protoJSONCase = CaseConvention{
+	JoinStyle: CustomSplitJoinStyle(func(components []string) string {
+		return ProtoJSONName(strings.Join(components, "_"))
+	}, LowerSnakeCase.Split),
+	InitialCase:    func(s string) string { return s },
+	SubsequentCase: func(s string) string { return s },
+	Example:        "protoJsonName",
+}
+
+// ProtoJSONCaser converts protobuf field names to their JSON names. Its
+// results are the same as those of ProtoJSONName, but it can be used wherever
+// a Caser is expected, such as with RetagStruct.
+var ProtoJSONCaser = Caser{
+	From:            LowerSnakeCase,
+	To:              protoJSONCase,
+	EmptyComponents: EmptyComponentsPreserve,
+}
+
+// ProtoMethodCase is the convention of protobuf RPC method names, such as
+// "GetUserProfile". It is UpperCamelCase under another name, which already
+// keeps known initialisms in uppercase as in "GetHTTPConfig", so method names
+// convert to snake_case HTTP routes and lowerCamelCase client methods and
+// back.
+var ProtoMethodCase = UpperCamelCase.WithExample("ProtoMethodCase")
@@ -0,0 +1,56 @@
+package varcaser
+
+import "testing"
+
+var protoJSONNames = map[string]string{
+	"foo_bar":     "fooBar",
+	"fooBar":      "fooBar",
+	"FooBar":      "FooBar",
+	"foo_Bar":     "fooBar",
+	"foo_123_bar": "foo123Bar",
+	"foo_3bar":    "foo3bar",
+	"field_name1": "fieldName1",
+	"http_url":    "httpUrl",
+	"user_id":     "userId",
+	"_foo":        "Foo",
+	"foo_":        "foo",
+	"a__b":        "aB",
+	"__double__":  "Double",
+	"__":          "",
+	"":            "",
+}
+
+func TestProtoJSONName(t *testing.T) {
+	for field, json := range protoJSONNames {
+		AssertEqual(ProtoJSONName(field), json, t)
+	}
+}
+
+func TestProtoJSONCaser(t *testing.T) {
+	for field, json := range protoJSONNames {
+		AssertEqual(ProtoJSONCaser.String(field), json, t)
+	}
+}
+
+func TestProtoMethodCase(t *testing.T) {
+	toSnake := Caser{From: ProtoMethodCase, To: LowerSnakeCase}
+	toCamel := Caser{From: ProtoMethodCase, To: LowerCamelCase}
+	fromSnake := Caser{From: LowerSnakeCase, To: ProtoMethodCase}
+	fromCamel := Caser{From: LowerCamelCase, To: ProtoMethodCase}
+
+	AssertEqual(toSnake.String("GetUserProfile"), "get_user_profile", t)
+	AssertEqual(toCamel.String("GetUserProfile"), "getUserProfile", t)
+
+	AssertEqual(toSnake.String("GetHTTPConfig"), "get_http_config", t)
+	AssertEqual(toCamel.String("GetHTTPConfig"), "getHTTPConfig", t)
+	AssertEqual(fromSnake.String("get_http_config"), "GetHTTPConfig", t)
+	AssertEqual(fromCamel.String("getHTTPConfig"), "GetHTTPConfig", t)
+
+	AssertEqual(ProtoMethodCase.Name(), "proto_method", t)
+	AssertIdentical(ProtoMethodCase.InitialCase, UpperCamelCase.InitialCase, t)
+
+	for _, method := range []string{"GetUserProfile", "GetHTTPConfig", "ListUserIDs", "DeleteJSONAPIKey"} {
+		AssertEqual(toSnake.RoundTrips(method), true, t)
+		AssertEqual(toCamel.RoundTrips(method), true, t)
+	}
+}
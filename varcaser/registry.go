@@ -0,0 +1,126 @@
+package varcaser
+
+// This file defines the names by which the predefined CaseConventions can be
+// looked up.
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// predefinedConventions are the CaseConventions defined by this package, in
+// the order they are defined in.
+var predefinedConventions = []CaseConvention{
+	LowerSnakeCase, ScreamingSnakeCase, KebabCase, UpperKebabCase, TrainCase,
+	ScreamingKebabCase, CobolCase, HttpHeaderCase, UpperCamelCase,
+	LowerCamelCase, UpperCamelCaseKeepCaps, LowerCamelCaseKeepCaps,
+	DotLowerCase, DotScreamingCase, FlatCase, UpperFlatCase, TitleSpaceCase,
+	SentenceCase, DoubleColonCase, PascalSnakeCase, AdaCase, CamelSnakeCase,
+	SlashLowerCase, SlashScreamingCase, ProtoMethodCase, PowerShellCase,
+}
+
+// conventionsByName maps the normalized names of the predefined
+// CaseConventions, and some common aliases, to the conventions. See
+// normalizeConventionName.
+var conventionsByName = map[string]CaseConvention{
+	"lowersnake":         LowerSnakeCase,
+	"screamingsnake":     ScreamingSnakeCase,
+	"kebab":              KebabCase,
+	"upperkebab":         UpperKebabCase,
+	"train":              TrainCase,
+	"screamingkebab":     ScreamingKebabCase,
+	"cobol":              CobolCase,
+	"httpheader":         HttpHeaderCase,
+	"uppercamel":         UpperCamelCase,
+	"lowercamel":         LowerCamelCase,
+	"uppercamelkeepcaps": UpperCamelCaseKeepCaps,
+	"lowercamelkeepcaps": LowerCamelCaseKeepCaps,
+	"dotlower":           DotLowerCase,
+	"dotscreaming":       DotScreamingCase,
+	"flat":               FlatCase,
+	"upperflat":          UpperFlatCase,
+	"titlespace":         TitleSpaceCase,
+	"sentence":           SentenceCase,
+	"doublecolon":        DoubleColonCase,
+	"pascalsnake":        PascalSnakeCase,
+	"ada":                AdaCase,
+	"camelsnake":         CamelSnakeCase,
+	"slashlower":         SlashLowerCase,
+	"slashscreaming":     SlashScreamingCase,
+	"protomethod":        ProtoMethodCase,
+	"powershell":         PowerShellCase,
+
+	// Aliases.
+	"snake":      LowerSnakeCase,
+	"uppersnake": ScreamingSnakeCase,
+	"constant":   ScreamingSnakeCase,
+	"lowerkebab": KebabCase,
+	"dash":       KebabCase,
+	"header":     HttpHeaderCase,
+	"pascal":     UpperCamelCase,
+	"camel":      LowerCamelCase,
+	"dot":        DotLowerCase,
+	"title":      TitleSpaceCase,
+	"mixed":      AdaCase,
+	"slash":      SlashLowerCase,
+}
+
+// ConventionByName returns the predefined CaseConvention with the given name,
+// such as "lower_snake", "UpperCamel" or "kebab-case", and whether there is
+// one. Names are the names of the package-level variables, with or without
+// "Case", and a few common aliases such as "snake", "pascal" and
+// "constant". They are matched case-insensitively, ignoring underscores,
+// hyphens, dots and spaces.
+func ConventionByName(name string) (CaseConvention, bool) {
+	convention, ok := conventionsByName[normalizeConventionName(name)]
+	return convention, ok
+}
+
+// normalizeConventionName lowercases name and removes its separators and the
+// word "case", so that "lower_snake_case" and "LowerSnake" both become
+// "lowersnake".
+func normalizeConventionName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '_', '-', '.', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(name))
+	return strings.ReplaceAll(name, "case", "")
+}
+
+// ErrUnknownConvention is returned when a ConventionName names none of the
+// predefined CaseConventions.
+var ErrUnknownConvention = fmt.Errorf("Unknown case convention.")
+
+// A ConventionName is the name of a predefined CaseConvention, as accepted by
+// ConventionByName. It is marshaled as a JSON string, and unmarshaling fails
+// with ErrUnknownConvention for names that ConventionByName doesn't know, so
+// configuration can select conventions by name.
+type ConventionName string
+
+// Convention returns the CaseConvention named n and whether there is one.
+func (n ConventionName) Convention() (CaseConvention, bool) {
+	return ConventionByName(string(n))
+}
+
+// MarshalJSON returns n as a JSON string.
+func (n ConventionName) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(n))
+}
+
+// UnmarshalJSON stores the JSON string data in n, or returns an error wrapping
+// ErrUnknownConvention if it names no predefined CaseConvention.
+func (n *ConventionName) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	if _, ok := ConventionByName(name); !ok {
+		return fmt.Errorf("%w %q", ErrUnknownConvention, name)
+	}
+	*n = ConventionName(name)
+	return nil
+}
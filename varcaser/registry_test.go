@@ -0,0 +1,102 @@
+package varcaser
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestConventionByName(t *testing.T) {
+	names := map[string]CaseConvention{
+		"LowerSnakeCase":         LowerSnakeCase,
+		"ScreamingSnakeCase":     ScreamingSnakeCase,
+		"KebabCase":              KebabCase,
+		"UpperKebabCase":         UpperKebabCase,
+		"TrainCase":              TrainCase,
+		"ScreamingKebabCase":     ScreamingKebabCase,
+		"CobolCase":              CobolCase,
+		"HttpHeaderCase":         HttpHeaderCase,
+		"UpperCamelCase":         UpperCamelCase,
+		"LowerCamelCase":         LowerCamelCase,
+		"UpperCamelCaseKeepCaps": UpperCamelCaseKeepCaps,
+		"LowerCamelCaseKeepCaps": LowerCamelCaseKeepCaps,
+		"DotLowerCase":           DotLowerCase,
+		"DotScreamingCase":       DotScreamingCase,
+		"FlatCase":               FlatCase,
+		"UpperFlatCase":          UpperFlatCase,
+		"TitleSpaceCase":         TitleSpaceCase,
+		"SentenceCase":           SentenceCase,
+		"DoubleColonCase":        DoubleColonCase,
+		"PascalSnakeCase":        PascalSnakeCase,
+		"AdaCase":                AdaCase,
+		"CamelSnakeCase":         CamelSnakeCase,
+		"SlashLowerCase":         SlashLowerCase,
+		"SlashScreamingCase":     SlashScreamingCase,
+		"ProtoMethodCase":        ProtoMethodCase,
+		"PowerShellCase":         PowerShellCase,
+
+		"lower_snake": LowerSnakeCase,
+		"UpperCamel":  UpperCamelCase,
+		"lower_camel": LowerCamelCase,
+		"kebab":       KebabCase,
+		"kebab-case":  KebabCase,
+		"snake":       LowerSnakeCase,
+		"pascal":      UpperCamelCase,
+		"CONSTANT":    ScreamingSnakeCase,
+		"http header": HttpHeaderCase,
+		"dot.lower":   DotLowerCase,
+	}
+
+	const sample = "getHTTPStatus_Code"
+	from := Caser{From: LowerCamelCase, To: LowerSnakeCase, LenientSplit: true}
+	for name, expected := range names {
+		convention, ok := ConventionByName(name)
+		if !ok {
+			t.Errorf("no convention named %q", name)
+			continue
+		}
+		AssertEqual(convention.Example, expected.Example, t)
+		AssertEqual(Caser{From: LowerSnakeCase, To: convention}.String(from.String(sample)),
+			Caser{From: LowerSnakeCase, To: expected}.String(from.String(sample)), t)
+	}
+
+	if len(names) < len(predefinedConventions) {
+		t.Errorf("only %d names for %d predefined conventions", len(names), len(predefinedConventions))
+	}
+	for _, name := range []string{"", "case", "unknown", "lower_snek"} {
+		if _, ok := ConventionByName(name); ok {
+			t.Errorf("unexpected convention named %q", name)
+		}
+	}
+}
+
+func TestConventionNameJSON(t *testing.T) {
+	type config struct {
+		From ConventionName `json:"from"`
+		To   ConventionName `json:"to"`
+	}
+
+	var specimen config
+	err := json.Unmarshal([]byte(`{"from":"lower_camel","to":"kebab"}`), &specimen)
+	AssertEqual(err, nil, t)
+	AssertEqual(specimen, config{From: "lower_camel", To: "kebab"}, t)
+
+	from, ok := specimen.From.Convention()
+	AssertEqual(ok, true, t)
+	to, ok := specimen.To.Convention()
+	AssertEqual(ok, true, t)
+	AssertEqual(Caser{From: from, To: to}.String("maxRetryCount"), "max-retry-count", t)
+
+	data, err := json.Marshal(specimen)
+	AssertEqual(err, nil, t)
+	AssertEqual(string(data), `{"from":"lower_camel","to":"kebab"}`, t)
+}
+
+func TestConventionNameJSONUnknown(t *testing.T) {
+	var name ConventionName
+	err := json.Unmarshal([]byte(`"lower_snek"`), &name)
+	AssertEqual(errors.Is(err, ErrUnknownConvention), true, t)
+	AssertEqual(name, ConventionName(""), t)
+
+	AssertEqual(json.Unmarshal([]byte(`42`), &name) != nil, true, t)
+}
@@ -0,0 +1,110 @@
+package varcaser
+
+// This file defines the conversion of struct field names.
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrNotStruct is returned when a value that should be a struct, or a pointer
+// to one, is not.
+var ErrNotStruct = fmt.Errorf("Not a struct.")
+
+// RetagStruct returns a map from the name of each exported field of the struct
+// v, or the struct v points to, to that name converted with c. The fields of
+// embedded structs are included as if they were fields of v, the way
+// encoding/json treats them.
+func (c Caser) RetagStruct(v interface{}) (map[string]string, error) {
+	return c.RetagStructWithTag(v, "")
+}
+
+// RetagStructWithTag is like RetagStruct, but fields that have a name in their
+// struct tag under key, such as `json:"name,omitempty"`, are mapped to that
+// name instead of being converted. Fields tagged "-" are left out.
+func (c Caser) RetagStructWithTag(v interface{}, key string) (map[string]string, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+
+	names := map[string]string{}
+	c.retagFields(t, key, names)
+	return names, nil
+}
+
+// retagFields adds the fields of the struct type t to names. Like
+// encoding/json, it visits the embedded structs breadth first, so that a
+// field wins over the promoted fields of the same name, and visits each
+// struct type once, so that a struct that embeds itself, directly or not,
+// contributes its fields once.
+func (c Caser) retagFields(t reflect.Type, key string, names map[string]string) {
+	visited := map[reflect.Type]bool{t: true}
+	for next := []reflect.Type{t}; len(next) > 0; {
+		current := next
+		next = nil
+		// The names found at the current depth, which are only added to
+		// names once the whole depth has been visited.
+		found := map[string]string{}
+		for _, t := range current {
+			c.retagDepth(t, key, names, found, &next, visited)
+		}
+		for name, tagged := range found {
+			names[name] = tagged
+		}
+	}
+}
+
+// retagDepth adds the fields of the struct type t that are not in names to
+// found, and the embedded structs of t that have not been visited to next.
+func (c Caser) retagDepth(t reflect.Type, key string, names, found map[string]string, next *[]reflect.Type, visited map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tagged := ""
+		if key != "" {
+			tag, ok := field.Tag.Lookup(key)
+			if tag == "-" {
+				continue
+			}
+			if ok {
+				tagged = strings.Split(tag, ",")[0]
+			}
+		}
+
+		if field.Anonymous && tagged == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if !visited[embedded] {
+					visited[embedded] = true
+					*next = append(*next, embedded)
+				}
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			// Unexported.
+			continue
+		}
+		if _, ok := names[field.Name]; ok {
+			// Shadowed by a shallower field.
+			continue
+		}
+		if _, ok := found[field.Name]; ok {
+			continue
+		}
+		if tagged != "" {
+			found[field.Name] = tagged
+		} else {
+			found[field.Name] = c.String(field.Name)
+		}
+	}
+}
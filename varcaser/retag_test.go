@@ -0,0 +1,80 @@
+package varcaser
+
+import "testing"
+
+type retagEmbedded struct {
+	CreatedAt string
+	UpdatedAt string
+}
+
+type retagSpecimen struct {
+	retagEmbedded
+	UserID    int
+	FirstName string `json:"given_name,omitempty"`
+	Password  string `json:"-"`
+	internal  bool
+}
+
+func TestRetagStruct(t *testing.T) {
+	c := Caser{From: UpperCamelCase, To: LowerSnakeCase}
+
+	specimen, err := c.RetagStruct(retagSpecimen{})
+	expected := map[string]string{
+		"CreatedAt": "created_at",
+		"UpdatedAt": "updated_at",
+		"UserID":    "user_id",
+		"FirstName": "first_name",
+		"Password":  "password",
+	}
+	AssertEqual(err, nil, t)
+	AssertEqual(specimen, expected, t)
+}
+
+func TestRetagStructWithTag(t *testing.T) {
+	c := Caser{From: UpperCamelCase, To: LowerSnakeCase}
+
+	specimen, err := c.RetagStructWithTag(&retagSpecimen{}, "json")
+	expected := map[string]string{
+		"CreatedAt": "created_at",
+		"UpdatedAt": "updated_at",
+		"UserID":    "user_id",
+		"FirstName": "given_name",
+	}
+	AssertEqual(err, nil, t)
+	AssertEqual(specimen, expected, t)
+}
+
+func TestRetagStructNotStruct(t *testing.T) {
+	c := Caser{From: UpperCamelCase, To: LowerSnakeCase}
+
+	for _, v := range []interface{}{nil, 42, "UserID", []retagSpecimen{}} {
+		_, err := c.RetagStruct(v)
+		AssertEqual(err, ErrNotStruct, t)
+	}
+}
+
+type retagNode struct {
+	*retagNode
+	NodeName string
+}
+
+type retagOuter struct {
+	CreatedAt string `json:"created"`
+	retagEmbedded
+}
+
+func TestRetagStructEmbedding(t *testing.T) {
+	c := Caser{From: UpperCamelCase, To: LowerSnakeCase}
+
+	specimen, err := c.RetagStruct(retagNode{})
+	AssertEqual(err, nil, t)
+	AssertEqual(specimen, map[string]string{"NodeName": "node_name"}, t)
+
+	specimen, err = c.RetagStructWithTag(retagOuter{}, "json")
+	expected := map[string]string{
+		"CreatedAt": "created",
+		"UpdatedAt": "updated_at",
+	}
+	AssertEqual(err, nil, t)
+	AssertEqual(specimen, expected, t)
+}
@@ -0,0 +1,20 @@
+package varcaser
+
+import "testing"
+
+func TestCaserSQLColumn(t *testing.T) {
+	c := Caser{From: UpperCamelCase}
+
+	AssertEqual(c.SQLColumn("Order", "postgres"), `"order"`, t)
+	AssertEqual(c.SQLColumn("Order", "mysql"), "`order`", t)
+	AssertEqual(c.SQLColumn("UserName", "postgres"), "user_name", t)
+	AssertEqual(c.SQLColumn("UserName", "mysql"), "user_name", t)
+
+	// USER is reserved in PostgreSQL, but not in MySQL.
+	AssertEqual(c.SQLColumn("User", "PostgreSQL"), `"user"`, t)
+	AssertEqual(c.SQLColumn("User", "MySQL"), "user", t)
+
+	AssertEqual(c.SQLColumn("CurrentUser", "postgres"), `"current_user"`, t)
+	AssertEqual(c.SQLColumn("Select", "sqlite"), `"select"`, t)
+	AssertEqual(Caser{From: ScreamingSnakeCase, To: KebabCase}.SQLColumn("CREATED_AT", "mysql"), "created_at", t)
+}
@@ -0,0 +1,208 @@
+package varcaser
+
+// This file defines the conversion of identifiers embedded in streams of
+// text.
+
+import (
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// isIdentifierRune reports whether r can be part of an identifier, following
+// the Go rules: letters, digits and underscores.
+func isIdentifierRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// IdentifierSplit is a bufio.SplitFunc that returns each identifier in the
+// input as a token, skipping everything in between. Identifiers follow the Go
+// rules: a letter or underscore followed by letters, digits and underscores.
+// Runs of letters and digits that start with a digit, such as "2nd", are
+// skipped as well.
+func IdentifierSplit(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	start := 0 // start of the current run of identifier runes, if inRun
+	inRun := false
+	i := 0
+	for i < len(data) {
+		if !atEOF && !utf8.FullRune(data[i:]) {
+			break
+		}
+
+		r, size := utf8.DecodeRune(data[i:])
+		if isIdentifierRune(r) {
+			if !inRun {
+				start = i
+				inRun = true
+			}
+		} else if inRun {
+			if isIdentifierStart(data[start:]) {
+				return i, data[start:i], nil
+			}
+			inRun = false
+		}
+		i += size
+	}
+
+	switch {
+	case !inRun:
+		// Everything up to i can be skipped.
+		return i, nil, nil
+	case !atEOF:
+		// The identifier may continue in more data.
+		return start, nil, nil
+	case isIdentifierStart(data[start:]):
+		return len(data), data[start:], nil
+	}
+	return len(data), nil, nil
+}
+
+// isIdentifierStart reports whether the run of identifier runes at the start
+// of b is an identifier, that is, whether it doesn't start with a digit.
+func isIdentifierStart(b []byte) bool {
+	r, _ := utf8.DecodeRune(b)
+	return !unicode.IsDigit(r)
+}
+
+// convertTokens appends src to dst, converting every identifier with c and
+// copying everything else unchanged. Unless atEOF, an identifier or rune at
+// the end of src that may continue in further input is left unconsumed. It
+// returns the extended dst and the number of bytes of src consumed.
+func (c Caser) convertTokens(dst, src []byte, atEOF bool) ([]byte, int) {
+	start := 0 // start of the current identifier, if inIdentifier
+	inIdentifier := false
+	for i := 0; i < len(src); {
+		if !atEOF && !utf8.FullRune(src[i:]) {
+			if inIdentifier {
+				return dst, start
+			}
+			return dst, i
+		}
+
+		r, size := utf8.DecodeRune(src[i:])
+		if isIdentifierRune(r) {
+			if !inIdentifier {
+				start = i
+				inIdentifier = true
+			}
+		} else {
+			if inIdentifier {
+				dst = append(dst, c.String(string(src[start:i]))...)
+				inIdentifier = false
+			}
+			dst = append(dst, src[i:i+size]...)
+		}
+		i += size
+	}
+
+	if inIdentifier {
+		if !atEOF {
+			return dst, start
+		}
+		dst = append(dst, c.String(string(src[start:]))...)
+	}
+	return dst, len(src)
+}
+
+// writer is the io.WriteCloser returned by Caser.NewWriter.
+type writer struct {
+	c       Caser
+	w       io.Writer
+	pending []byte // input that may be the start of a longer identifier
+	out     []byte
+	err     error // the first error from w, returned by every later call
+}
+
+// NewWriter returns a writer that converts every identifier written to it
+// with c before writing it to w. Identifiers are runs of letters, digits and
+// underscores, so separators such as '-' or '.' are passed through unchanged
+// along with spaces and punctuation. An identifier may span several calls to
+// Write, hence the end of the input is only written when the writer is
+// closed. Closing the writer does not close w.
+func (c Caser) NewWriter(w io.Writer) io.WriteCloser {
+	return &writer{c: c, w: w}
+}
+
+// Write takes all of p even when writing to the underlying writer fails,
+// since p can't be taken back from the pending input. The error is returned by
+// every later call, so that retrying doesn't write p twice.
+func (w *writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.pending = append(w.pending, p...)
+	w.err = w.convert(false)
+	return len(p), w.err
+}
+
+// Close writes the last identifier. Like gzip.Writer, it leaves the
+// underlying writer open.
+func (w *writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.err = w.convert(true)
+	return w.err
+}
+
+// convert writes as much of the pending input as it can.
+func (w *writer) convert(atEOF bool) error {
+	var n int
+	w.out, n = w.c.convertTokens(w.out[:0], w.pending, atEOF)
+	w.pending = append(w.pending[:0], w.pending[n:]...)
+	if len(w.out) == 0 {
+		return nil
+	}
+	_, err := w.w.Write(w.out)
+	return err
+}
+
+// reader is the io.Reader returned by Caser.NewReader.
+type reader struct {
+	c       Caser
+	r       io.Reader
+	buf     []byte // scratch space for reading from r
+	pending []byte // input that may be the start of a longer identifier
+	out     []byte // converted output that hasn't been read yet
+	err     error  // the error that ended the input, returned once out is read
+}
+
+// NewReader returns a reader that reads from r and converts every identifier
+// with c, the way NewWriter does. An identifier may span several reads from
+// r, so it is only returned once the rune after it, or the end of the input,
+// has been read.
+func (c Caser) NewReader(r io.Reader) io.Reader {
+	return &reader{c: c, r: r}
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for len(r.out) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.fill()
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+// fill reads once from the underlying reader and converts as much of the
+// pending input as it can. All of it is converted once the input ends.
+func (r *reader) fill() {
+	if r.buf == nil {
+		r.buf = make([]byte, 4096)
+	}
+	n, err := r.r.Read(r.buf)
+	r.pending = append(r.pending, r.buf[:n]...)
+	if err != nil {
+		r.err = err
+	}
+
+	var consumed int
+	r.out, consumed = r.c.convertTokens(r.out[:0], r.pending, r.err != nil)
+	r.pending = append(r.pending[:0], r.pending[consumed:]...)
+}
@@ -0,0 +1,153 @@
+package varcaser
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestCaserWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := Caser{From: LowerCamelCase, To: LowerSnakeCase}.NewWriter(&buf)
+
+	for _, chunk := range []string{"var my", "Var", "iable = other", "Value(", "x);\n", "lastOne"} {
+		n, err := w.Write([]byte(chunk))
+		AssertEqual(n, len(chunk), t)
+		AssertEqual(err, nil, t)
+	}
+	AssertEqual(buf.String(), "var my_variable = other_value(x);\n", t)
+
+	AssertEqual(w.Close(), nil, t)
+	AssertEqual(buf.String(), "var my_variable = other_value(x);\nlast_one", t)
+}
+
+// closeRecorder is a bytes.Buffer that records whether it was closed.
+type closeRecorder struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestCaserWriterClose(t *testing.T) {
+	var buf closeRecorder
+	w := Caser{From: LowerCamelCase, To: LowerSnakeCase}.NewWriter(&buf)
+
+	w.Write([]byte("lastOne"))
+	AssertEqual(w.Close(), nil, t)
+	AssertEqual(buf.String(), "last_one", t)
+	AssertEqual(buf.closed, false, t)
+}
+
+func TestCaserWriterSplitRune(t *testing.T) {
+	var buf bytes.Buffer
+	w := Caser{From: LowerCamelCase, To: KebabCase}.NewWriter(&buf)
+
+	input := []byte("caféÉclair + x")
+	for i := range input {
+		w.Write(input[i : i+1])
+	}
+	w.Close()
+	AssertEqual(buf.String(), "café-éclair + x", t)
+}
+
+// failingWriter fails its first Write, and writes to w afterwards.
+type failingWriter struct {
+	w      io.Writer
+	failed bool
+}
+
+var errWriteFailed = fmt.Errorf("Write failed.")
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if !f.failed {
+		f.failed = true
+		return 0, errWriteFailed
+	}
+	return f.w.Write(p)
+}
+
+func TestCaserWriterError(t *testing.T) {
+	var buf bytes.Buffer
+	w := Caser{From: LowerCamelCase, To: LowerSnakeCase}.NewWriter(&failingWriter{w: &buf})
+
+	n, err := w.Write([]byte("myVar = "))
+	AssertEqual(n, 8, t)
+	AssertEqual(err, errWriteFailed, t)
+
+	// Retrying doesn't write the input twice.
+	n, err = w.Write([]byte("myVar = "))
+	AssertEqual(n, 0, t)
+	AssertEqual(err, errWriteFailed, t)
+	AssertEqual(w.Close(), errWriteFailed, t)
+	AssertEqual(buf.String(), "", t)
+}
+
+func TestCaserReader(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	input := "var myVariable = otherValue(x);\ncaféÉclair + lastOne"
+	whole, _ := c.convertTokens(nil, []byte(input), true)
+	want := string(whole)
+	AssertEqual(want, "var my_variable = other_value(x);\ncafé_éclair + last_one", t)
+
+	readers := map[string]func(io.Reader) io.Reader{
+		"whole":    func(r io.Reader) io.Reader { return r },
+		"one byte": iotest.OneByteReader,
+		"half":     iotest.HalfReader,
+		"data err": iotest.DataErrReader,
+	}
+	for name, wrap := range readers {
+		result, err := io.ReadAll(c.NewReader(wrap(strings.NewReader(input))))
+		AssertEqual(err, nil, t)
+		if string(result) != want {
+			t.Errorf("%s: got %q, want %q", name, result, want)
+		}
+	}
+
+	if err := iotest.TestReader(c.NewReader(iotest.OneByteReader(strings.NewReader(input))), []byte(want)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCaserReaderError(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: KebabCase}
+	r := io.MultiReader(strings.NewReader("someName "), iotest.ErrReader(io.ErrUnexpectedEOF))
+	result, err := io.ReadAll(c.NewReader(r))
+	AssertEqual(string(result), "some-name ", t)
+	AssertEqual(err, io.ErrUnexpectedEOF, t)
+}
+
+func scanIdentifiers(r io.Reader) []string {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(IdentifierSplit)
+	tokens := []string{}
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	return tokens
+}
+
+func TestIdentifierSplit(t *testing.T) {
+	src := "func parseHTTP(my_var, other-name int) { return 2nd + π_value / x1 }"
+
+	specimen := scanIdentifiers(strings.NewReader(src))
+	expected := []string{"func", "parseHTTP", "my_var", "other", "name", "int", "return", "π_value", "x1"}
+	AssertEqual(specimen, expected, t)
+}
+
+func TestIdentifierSplitChunked(t *testing.T) {
+	// One byte at a time, so tokens and multi-byte runes are cut off at
+	// every possible point.
+	src := "naïveΣum := café_au_lait"
+
+	specimen := scanIdentifiers(iotest.OneByteReader(strings.NewReader(src)))
+	expected := []string{"naïveΣum", "café_au_lait"}
+	AssertEqual(specimen, expected, t)
+}
@@ -10,14 +10,14 @@ package varcaser
 import "strings"
 
 var LowerSnakeCase = CaseConvention{
-	JoinStyle:      SimpleJoinStyle("_"),
+	JoinStyle:      snakeJoinStyle,
 	InitialCase:    strings.ToLower,
 	SubsequentCase: strings.ToLower,
 	Example:        "lower_snake_case",
 }
 
 var ScreamingSnakeCase = CaseConvention{
-	JoinStyle:      SimpleJoinStyle("_"),
+	JoinStyle:      snakeJoinStyle,
 	InitialCase:    strings.ToUpper,
 	SubsequentCase: strings.ToUpper,
 	Example:        "SCREAMING_SNAKE_CASE",
@@ -37,6 +37,9 @@ var UpperKebabCase = CaseConvention{
 	Example:        "Upper-Kebab-Case",
 }
 
+// TrainCase is UpperKebabCase under the name it is often known by.
+var TrainCase = UpperKebabCase.WithExample("Train-Case")
+
 var ScreamingKebabCase = CaseConvention{
 	JoinStyle:      SimpleJoinStyle("-"),
 	InitialCase:    strings.ToUpper,
@@ -44,6 +47,9 @@ var ScreamingKebabCase = CaseConvention{
 	Example:        "SCREAMING-KEBAB-CASE",
 }
 
+// CobolCase is ScreamingKebabCase under the name of the language that uses it.
+var CobolCase = ScreamingKebabCase.WithExample("COBOL-CASE")
+
 var HttpHeaderCase = CaseConvention{
 	JoinStyle:      SimpleJoinStyle("-"),
 	InitialCase:    ToHttpTitle,
@@ -51,30 +57,128 @@ var HttpHeaderCase = CaseConvention{
 	Example:        "HTTP-Header-Case",
 }
 
-var UpperCamelCase = CaseConvention{
+var PowerShellCase = CaseConvention{
 	JoinStyle:      camelJoinStyle,
-	InitialCase:    ToStrictTitle,
-	SubsequentCase: ToStrictTitle,
-	Example:        "UpperCamelCase",
+	InitialCase:    ToPowerShellTitle,
+	SubsequentCase: ToPowerShellTitle,
+	Example:        "PowerShellCase",
+}
+
+var UpperCamelCase = CaseConvention{
+	JoinStyle:       camelJoinStyle,
+	InitialCase:     ToStrictTitle,
+	SubsequentCase:  ToStrictTitle,
+	Example:         "UpperCamelCase",
+	KeepInitialisms: true,
 }
 
 var LowerCamelCase = CaseConvention{
-	JoinStyle:      camelJoinStyle,
-	InitialCase:    strings.ToLower,
-	SubsequentCase: ToStrictTitle,
-	Example:        "lowerCamelCase",
+	JoinStyle:       camelJoinStyle,
+	InitialCase:     strings.ToLower,
+	SubsequentCase:  ToStrictTitle,
+	Example:         "lowerCamelCase",
+	KeepInitialisms: true,
 }
 
 var UpperCamelCaseKeepCaps = CaseConvention{
-	JoinStyle:      camelJoinStyle,
-	InitialCase:    strings.Title,
-	SubsequentCase: strings.Title,
-	Example:        "UpperCamelCase",
+	JoinStyle:       camelJoinStyle,
+	InitialCase:     ToTitleFirst,
+	SubsequentCase:  ToTitleFirst,
+	Example:         "UpperCamelCaseKeepCaps",
+	KeepInitialisms: true,
 }
 
 var LowerCamelCaseKeepCaps = CaseConvention{
-	JoinStyle:      camelJoinStyle,
+	JoinStyle:       camelJoinStyle,
+	InitialCase:     strings.ToLower,
+	SubsequentCase:  ToTitleFirst,
+	Example:         "lowerCamelCaseKeepCaps",
+	KeepInitialisms: true,
+}
+
+var DotLowerCase = CaseConvention{
+	JoinStyle:      SimpleJoinStyle("."),
+	InitialCase:    strings.ToLower,
+	SubsequentCase: strings.ToLower,
+	Example:        "dot.lower.case",
+}
+
+var DotScreamingCase = CaseConvention{
+	JoinStyle:      SimpleJoinStyle("."),
+	InitialCase:    strings.ToUpper,
+	SubsequentCase: strings.ToUpper,
+	Example:        "DOT.SCREAMING.CASE",
+}
+
+// Converting from FlatCase or UpperFlatCase is best-effort: the words can
+// only be recovered where the input still carries case changes.
+var FlatCase = CaseConvention{
+	JoinStyle:      flatJoinStyle,
 	InitialCase:    strings.ToLower,
-	SubsequentCase: strings.Title,
-	Example:        "lowerCamelCase",
+	SubsequentCase: strings.ToLower,
+	Example:        "flatcase",
+}
+
+var UpperFlatCase = CaseConvention{
+	JoinStyle:      flatJoinStyle,
+	InitialCase:    strings.ToUpper,
+	SubsequentCase: strings.ToUpper,
+	Example:        "UPPERFLATCASE",
+}
+
+var TitleSpaceCase = CaseConvention{
+	JoinStyle:       spaceJoinStyle,
+	InitialCase:     ToStrictTitle,
+	SubsequentCase:  ToStrictTitle,
+	Example:         "Title Space Case",
+	KeepInitialisms: true,
+}
+
+var SentenceCase = CaseConvention{
+	JoinStyle:       spaceJoinStyle,
+	InitialCase:     ToStrictTitle,
+	SubsequentCase:  strings.ToLower,
+	Example:         "Sentence case",
+	KeepInitialisms: true,
+}
+
+var DoubleColonCase = CaseConvention{
+	JoinStyle:       SimpleJoinStyle("::"),
+	InitialCase:     ToStrictTitle,
+	SubsequentCase:  ToStrictTitle,
+	Example:         "Double::Colon::Case",
+	KeepInitialisms: true,
+}
+
+var PascalSnakeCase = CaseConvention{
+	JoinStyle:       SimpleJoinStyle("_"),
+	InitialCase:     ToStrictTitle,
+	SubsequentCase:  ToStrictTitle,
+	Example:         "Pascal_Snake_Case",
+	KeepInitialisms: true,
+}
+
+// AdaCase is PascalSnakeCase under the name of the language that uses it.
+var AdaCase = PascalSnakeCase.WithExample("Ada_Case")
+
+var CamelSnakeCase = CaseConvention{
+	JoinStyle:       SimpleJoinStyle("_"),
+	InitialCase:     strings.ToLower,
+	SubsequentCase:  ToStrictTitle,
+	Example:         "camel_Snake_Case",
+	KeepInitialisms: true,
+}
+
+var SlashLowerCase = CaseConvention{
+	JoinStyle:      slashJoinStyle,
+	InitialCase:    strings.ToLower,
+	SubsequentCase: strings.ToLower,
+	Example:        "slash/lower/case",
+}
+
+var SlashScreamingCase = CaseConvention{
+	JoinStyle:      slashJoinStyle,
+	InitialCase:    strings.ToUpper,
+	SubsequentCase: strings.ToUpper,
+	Example:        "SLASH/SCREAMING/CASE",
 }
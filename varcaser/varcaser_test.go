@@ -1,8 +1,13 @@
 package varcaser
 
 import (
+	"errors"
+	"net/textproto"
 	"reflect"
+	"strings"
 	"testing"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/text/transform"
 )
@@ -88,12 +93,12 @@ func TestCaserSnakeToScreamingKebab(t *testing.T) {
 	AssertEqual(specimen, expected, t)
 }
 
-// AsyncHTTPRequest -> AsyncHttpRequest
+// AsyncMVCRequest -> AsyncMvcRequest
 func TestCaserCamelToCamelLoseCapitals(t *testing.T) {
 	c := Caser{From: UpperCamelCase, To: UpperCamelCase}
 
-	specimen := c.String("AsyncHTTPRequest")
-	expected := "AsyncHttpRequest"
+	specimen := c.String("AsyncMVCRequest")
+	expected := "AsyncMvcRequest"
 	AssertEqual(specimen, expected, t)
 }
 
@@ -145,3 +150,1496 @@ func TestCaserIsATransformer(t *testing.T) {
 	AssertEqual(err, transform.ErrShortDst, t)
 	AssertEqual(string(dst), "one-measley-variable", t)
 }
+
+func TestCaserCamelToDot(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: DotLowerCase}
+
+	specimen := c.String("myHttpServer")
+	expected := "my.http.server"
+	AssertEqual(specimen, expected, t)
+}
+
+func TestCaserDotToScreamingDot(t *testing.T) {
+	c := Caser{From: DotLowerCase, To: DotScreamingCase}
+
+	specimen := c.String("my.http.server")
+	expected := "MY.HTTP.SERVER"
+	AssertEqual(specimen, expected, t)
+}
+
+func TestCaserDotRoundTrip(t *testing.T) {
+	there := Caser{From: LowerCamelCase, To: DotLowerCase}
+	back := Caser{From: DotLowerCase, To: LowerCamelCase}
+
+	specimen := back.String(there.String("myHTTPServer"))
+	expected := "myHTTPServer"
+	AssertEqual(specimen, expected, t)
+}
+
+func TestCaserDotDoubledSeparator(t *testing.T) {
+	// Doubled separators produce an empty component, which every word case
+	// leaves empty, so it disappears when joined without a separator.
+	c := Caser{From: DotLowerCase, To: LowerCamelCase}
+
+	specimen := c.String("my..server")
+	expected := "myServer"
+	AssertEqual(specimen, expected, t)
+}
+
+func TestDotExamples(t *testing.T) {
+	for _, convention := range []CaseConvention{DotLowerCase, DotScreamingCase} {
+		c := Caser{From: convention, To: convention}
+		AssertEqual(c.String(convention.Example), convention.Example, t)
+	}
+}
+
+func TestCaserSeparatorMatrix(t *testing.T) {
+	expected := map[string]string{
+		DotLowerCase.Example:       "http.server.port",
+		DotScreamingCase.Example:   "HTTP.SERVER.PORT",
+		SlashLowerCase.Example:     "http/server/port",
+		SlashScreamingCase.Example: "HTTP/SERVER/PORT",
+	}
+	conventions := []CaseConvention{DotLowerCase, DotScreamingCase, SlashLowerCase, SlashScreamingCase}
+	for _, to := range conventions {
+		there := Caser{From: LowerCamelCase, To: to}
+		back := Caser{From: to, To: LowerCamelCase}
+		AssertEqual(there.String("httpServerPort"), expected[to.Example], t)
+		AssertEqual(back.String(expected[to.Example]), "httpServerPort", t)
+		AssertEqual(Caser{From: to, To: UpperCamelCase}.String(expected[to.Example]), "HTTPServerPort", t)
+
+		for _, from := range conventions {
+			c := Caser{From: from, To: to}
+			AssertEqual(c.String(expected[from.Example]), expected[to.Example], t)
+		}
+	}
+	AssertEqual(Caser{From: SlashScreamingCase, To: SlashScreamingCase}.String(SlashScreamingCase.Example), SlashScreamingCase.Example, t)
+}
+
+func TestCaserCamelToTrain(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: TrainCase}
+
+	AssertEqual(c.String("userId"), "User-Id", t)
+	AssertEqual(c.String("contentType"), "Content-Type", t)
+}
+
+func TestCaserTrainIsNotHttpHeader(t *testing.T) {
+	// HttpHeaderCase uppercases recognized acronyms, TrainCase titles
+	// every word.
+	AssertEqual(Caser{From: LowerSnakeCase, To: TrainCase}.String("www_authenticate"), "Www-Authenticate", t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: HttpHeaderCase}.String("www_authenticate"), "WWW-Authenticate", t)
+}
+
+func TestTrainSplit(t *testing.T) {
+	specimen := TrainCase.Split("Foo-Bar-Baz")
+	expected := []string{"Foo", "Bar", "Baz"}
+	AssertEqual(specimen, expected, t)
+}
+
+func TestCaserCamelToFlat(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: FlatCase}
+
+	AssertEqual(c.String("httpServer"), "httpserver", t)
+	AssertEqual(c.String("userID"), "userid", t)
+}
+
+func TestCaserSnakeToUpperFlat(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: UpperFlatCase}
+
+	specimen := c.String("http_server")
+	expected := "HTTPSERVER"
+	AssertEqual(specimen, expected, t)
+}
+
+func TestCaserFromFlatIsBestEffort(t *testing.T) {
+	c := Caser{From: FlatCase, To: LowerSnakeCase}
+
+	// Without case changes there is nothing to split on.
+	AssertEqual(c.String("httpserver"), "httpserver", t)
+	// Case changes that survive in the input are still used.
+	AssertEqual(c.String("httpServer"), "http_server", t)
+}
+
+func TestCaserWithInitialisms(t *testing.T) {
+	defaults := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+	custom := defaults.WithInitialisms([]string{"arn", "SKU"})
+
+	AssertEqual(defaults.String("role_arn"), "RoleArn", t)
+	AssertEqual(custom.String("role_arn"), "RoleARN", t)
+
+	AssertEqual(defaults.String("user_id"), "UserID", t)
+	AssertEqual(custom.String("user_id"), "UserId", t)
+}
+
+func TestCaserWithInitialismsCopies(t *testing.T) {
+	initialisms := []string{"ARN"}
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase}.WithInitialisms(initialisms)
+	initialisms[0] = "SKU"
+
+	AssertEqual(c.String("role_arn"), "RoleARN", t)
+	AssertEqual(c.String("item_sku"), "ItemSku", t)
+}
+
+func TestCaserWithoutInitialisms(t *testing.T) {
+	defaults := Caser{From: LowerCamelCase, To: LowerCamelCase}
+	c := defaults.WithoutInitialisms("id", "NOT-AN-INITIALISM")
+
+	AssertEqual(defaults.String("userId"), "userID", t)
+	AssertEqual(c.String("userId"), "userId", t)
+	AssertEqual(c.String("userUrl"), "userURL", t)
+}
+
+func TestCaserWithoutInitialismsAfterWith(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase}.
+		WithInitialisms([]string{"ARN", "SKU"}).
+		WithoutInitialisms("sku")
+
+	AssertEqual(c.String("role_arn"), "RoleARN", t)
+	AssertEqual(c.String("item_sku"), "ItemSku", t)
+}
+
+func TestCaserInitialismsAtBeginning(t *testing.T) {
+	AssertEqual(Caser{From: LowerSnakeCase, To: UpperCamelCase}.String("id_value"), "IDValue", t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: LowerCamelCase}.String("id_value"), "idValue", t)
+	// Only whole words are initialisms.
+	AssertEqual(Caser{From: LowerSnakeCase, To: UpperCamelCase}.String("identity_card"), "IdentityCard", t)
+}
+
+func TestCaserInitialismsInMiddle(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerCamelCase}
+
+	AssertEqual(c.String("getHttpUrlParser"), "getHTTPURLParser", t)
+	AssertEqual(c.String("asyncHttpRequest"), "asyncHTTPRequest", t)
+}
+
+func TestCaserMultipleInitialisms(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+
+	AssertEqual(c.String("json_api_to_xml"), "JSONAPIToXML", t)
+	AssertEqual(c.String("user_uuid_and_uid"), "UserUUIDAndUID", t)
+}
+
+func TestCaserAbuttingInitialisms(t *testing.T) {
+	// The camel split keeps HTTPURL together, which is still read as two
+	// initialisms.
+	c := Caser{From: LowerCamelCase, To: UpperCamelCase}
+
+	AssertEqual(c.String("getHTTPURLParser"), "GetHTTPURLParser", t)
+	AssertEqual(c.String("getUUIDUIDMap"), "GetUUIDUIDMap", t)
+}
+
+func TestCamelSplitDigits(t *testing.T) {
+	AssertEqual(camelJoinStyle.Split("parseHTML5Document"), []string{"parse", "HTML5", "Document"}, t)
+	AssertEqual(camelJoinStyle.Split("utf8Reader"), []string{"utf8", "Reader"}, t)
+	AssertEqual(camelJoinStyle.Split("version2Api"), []string{"version2", "Api"}, t)
+	AssertEqual(camelJoinStyle.Split("foo2"), []string{"foo2"}, t)
+	AssertEqual(camelJoinStyle.Split("ID2Name"), []string{"ID2", "Name"}, t)
+}
+
+func TestCaserDigitStyles(t *testing.T) {
+	cases := []struct {
+		digits   DigitStyle
+		input    string
+		expected []string
+	}{
+		{DigitsJoinPrevious, "version2Api", []string{"version2", "Api"}},
+		{DigitsStartWord, "version2Api", []string{"version", "2", "Api"}},
+		{DigitsSeparate, "version2Api", []string{"version", "2", "Api"}},
+		{DigitsJoinPrevious, "foo2", []string{"foo2"}},
+		{DigitsStartWord, "foo2", []string{"foo", "2"}},
+		{DigitsSeparate, "foo2", []string{"foo", "2"}},
+		{DigitsStartWord, "foo2bar", []string{"foo", "2bar"}},
+		{DigitsSeparate, "foo2bar", []string{"foo", "2", "bar"}},
+		{DigitsStartWord, "2ndPlace", []string{"2nd", "Place"}},
+		{DigitsSeparate, "2ndPlace", []string{"2", "nd", "Place"}},
+		{DigitsSeparate, "parseHTML5Document", []string{"parse", "HTML", "5", "Document"}},
+		{DigitsSeparate, "readUTF8Buffer", []string{"read", "UTF8", "Buffer"}},
+		{DigitsStartWord, "utf8Reader", []string{"utf8", "Reader"}},
+	}
+
+	for _, tc := range cases {
+		c := Caser{From: LowerCamelCase, To: LowerSnakeCase, Digits: tc.digits}
+		AssertEqual(c.words(tc.input), tc.expected, t)
+	}
+}
+
+func TestCaserDigitsSeparateToSnake(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase, Digits: DigitsSeparate}
+
+	AssertEqual(c.String("version2Api"), "version_2_api", t)
+	AssertEqual(c.String("utf8Reader"), "utf8_reader", t)
+}
+
+func TestCaserStringSlice(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+
+	cases := []struct {
+		in       []string
+		expected []string
+	}{
+		{nil, nil},
+		{[]string{}, []string{}},
+		{[]string{"user_id"}, []string{"userID"}},
+		{[]string{"first_name", "last_name", "id"}, []string{"firstName", "lastName", "id"}},
+	}
+
+	for _, tc := range cases {
+		AssertEqual(c.StringSlice(tc.in), tc.expected, t)
+	}
+}
+
+func BenchmarkCaserSnakeToCamel(b *testing.B) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.String("get_http_url_parser_for_user_id")
+	}
+}
+
+func BenchmarkCaserCamelToCamel(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: UpperCamelCase}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.String("getHttpUrlParserForUserId")
+	}
+}
+
+func TestCaserOverlappingInitialisms(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+
+	AssertEqual(c.String("uuid"), "UUID", t)
+	AssertEqual(c.String("uid"), "UID", t)
+	AssertEqual(c.String("user_uuid"), "UserUUID", t)
+	AssertEqual(c.String("user_uid"), "UserUID", t)
+}
+
+func TestCaserLongestInitialismFirst(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+
+	AssertEqual(c.String("uuid_value"), "UUIDValue", t)
+	AssertEqual(c.String("uuiduid"), "UUIDUID", t)
+	AssertEqual(c.String("uuids"), "UUIDs", t)
+
+	// The order initialisms are added in doesn't matter.
+	a := c.WithInitialisms([]string{"UU", "IDX", "UUIDX"})
+	b := c.WithInitialisms([]string{"UUIDX", "IDX", "UU"})
+	for _, s := range []string{"uuidx", "uuidx_uid", "uuuidx"} {
+		AssertEqual(a.String(s), b.String(s), t)
+	}
+	AssertEqual(a.String("uuidx"), "UUIDX", t)
+}
+
+func TestCaserInitialismsDeterministic(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}.WithAcronymMap(map[string]string{
+		"api": "Api",
+		"API": "API",
+		"Api": "APi",
+		"ios": "iOS",
+	})
+	inputs := []string{"user_uuid_api", "ios_uid_list", "http_url_id"}
+	want := make([]string, len(inputs))
+	for i, s := range inputs {
+		want[i] = c.String(s)
+	}
+	AssertEqual(want[0], "userUUIDAPI", t)
+
+	for n := 0; n < 100; n++ {
+		c := Caser{From: LowerSnakeCase, To: LowerCamelCase}.WithAcronymMap(map[string]string{
+			"api": "Api",
+			"API": "API",
+			"Api": "APi",
+			"ios": "iOS",
+		})
+		for i, s := range inputs {
+			AssertEqual(c.String(s), want[i], t)
+		}
+	}
+}
+
+func TestIsInitialisms(t *testing.T) {
+	AssertEqual(isInitialisms("uuid", commonInitialismSet), true, t)
+	AssertEqual(isInitialisms("Uid", commonInitialismSet), true, t)
+	AssertEqual(isInitialisms("UUIDUID", commonInitialismSet), true, t)
+	AssertEqual(isInitialisms("UUIDX", commonInitialismSet), false, t)
+	AssertEqual(isInitialisms("", commonInitialismSet), false, t)
+
+	// Long words are only matched against initialisms of plausible
+	// lengths, rather than every one of their substrings.
+	AssertEqual(isInitialisms(strings.Repeat("Id", 50000), commonInitialismSet), true, t)
+}
+
+func TestCaserNonASCIIRoundTrips(t *testing.T) {
+	toSnake := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	toCamel := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+
+	cases := []struct {
+		camel string
+		snake string
+	}{
+		{"númeroDeTeléfono", "número_de_teléfono"},
+		{"nombreUsuarioÁlvaro", "nombre_usuario_álvaro"},
+		{"ångströmUnit", "ångström_unit"},
+		{"όνομαΧρήστη", "όνομα_χρήστη"},
+		{"имяПользователя", "имя_пользователя"},
+		{"straßeName", "straße_name"},
+	}
+
+	for _, tc := range cases {
+		AssertEqual(toSnake.String(tc.camel), tc.snake, t)
+		AssertEqual(toCamel.String(tc.snake), tc.camel, t)
+	}
+}
+
+func TestCaserNonASCIITitle(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+
+	AssertEqual(c.String("ångström_unit"), "ÅngströmUnit", t)
+	AssertEqual(c.String("élan_vital"), "ÉlanVital", t)
+	// A decomposed accent stays attached to its letter.
+	AssertEqual(c.String("e\u0301lan_vital"), "E\u0301lanVital", t)
+	// Digraphs are titled, not uppercased.
+	AssertEqual(c.String("ǆemal_bey"), "ǅemalBey", t)
+}
+
+func TestToTitleFirst(t *testing.T) {
+	AssertEqual(ToTitleFirst(""), "", t)
+	AssertEqual(ToTitleFirst("don't"), "Don't", t)
+	AssertEqual(ToTitleFirst("hTTP"), "HTTP", t)
+	AssertEqual(ToTitleFirst("ǆ"), "ǅ", t)
+}
+
+func TestCaserTurkishCaseRules(t *testing.T) {
+	upper := Caser{From: LowerSnakeCase, To: ScreamingSnakeCase, CaseRules: unicode.TurkishCase}
+	lower := Caser{From: ScreamingSnakeCase, To: LowerSnakeCase, CaseRules: unicode.TurkishCase}
+	title := Caser{From: LowerSnakeCase, To: UpperCamelCase, CaseRules: unicode.TurkishCase}
+
+	AssertEqual(upper.String("id"), "İD", t)
+	AssertEqual(lower.String("ID"), "ıd", t)
+	AssertEqual(title.String("ilk_isim"), "İlkİsim", t)
+}
+
+func TestCaserDefaultCaseRules(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: ScreamingSnakeCase}
+
+	AssertEqual(c.String("id"), "ID", t)
+}
+
+func TestCaserSnakeToHttpHeader(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: HttpHeaderCase}
+
+	AssertEqual(c.String("www_authenticate"), "WWW-Authenticate", t)
+	AssertEqual(c.String("content_md5"), "Content-MD5", t)
+	AssertEqual(c.String("x_frame_options"), "X-Frame-Options", t)
+	AssertEqual(c.String("te"), "TE", t)
+}
+
+func TestToHttpTitleMultipleWords(t *testing.T) {
+	AssertEqual(ToHttpTitle("content-md5"), "Content-MD5", t)
+	AssertEqual(ToHttpTitle("x-xss-protection"), "X-XSS-Protection", t)
+	AssertEqual(ToHttpTitle("dnt"), "DNT", t)
+}
+
+func TestCaserSnakeToHttpHeaderStandard(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: HttpHeaderCase}
+
+	AssertEqual(c.String("content_type"), "Content-Type", t)
+	AssertEqual(c.String("x_forwarded_for"), "X-Forwarded-For", t)
+}
+
+func TestHttpHeaderCaseMatchesTextproto(t *testing.T) {
+	fromSnake := Caser{From: LowerSnakeCase, To: HttpHeaderCase}
+	roundTrip := Caser{From: HttpHeaderCase, To: HttpHeaderCase}
+
+	for _, header := range []string{
+		"accept-encoding",
+		"cache-control",
+		"content-length",
+		"content-type",
+		"if-modified-since",
+		"user-agent",
+		"x-forwarded-for",
+	} {
+		canonical := textproto.CanonicalMIMEHeaderKey(header)
+		AssertEqual(fromSnake.String(strings.Replace(header, "-", "_", -1)), canonical, t)
+		AssertEqual(roundTrip.String(canonical), canonical, t)
+	}
+}
+
+func TestHttpHeaderCaseExample(t *testing.T) {
+	c := Caser{From: HttpHeaderCase, To: HttpHeaderCase}
+	AssertEqual(c.String(HttpHeaderCase.Example), HttpHeaderCase.Example, t)
+}
+
+func TestCaserCamelToTitleSpace(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: TitleSpaceCase}
+
+	AssertEqual(c.String("firstName"), "First Name", t)
+	AssertEqual(c.String("userHttpId"), "User HTTP ID", t)
+}
+
+func TestCaserTitleSpaceToSnake(t *testing.T) {
+	c := Caser{From: TitleSpaceCase, To: LowerSnakeCase}
+
+	AssertEqual(c.String("First Name"), "first_name", t)
+	AssertEqual(c.String("  First   Name "), "first_name", t)
+}
+
+func TestTitleSpaceSplit(t *testing.T) {
+	AssertEqual(TitleSpaceCase.Split("User  HTTP ID"), []string{"User", "HTTP", "ID"}, t)
+}
+
+func TestCaserCamelToSentence(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: SentenceCase}
+
+	AssertEqual(c.String("getHttpStatus"), "Get HTTP status", t)
+	AssertEqual(c.String("numberOfItems"), "Number of items", t)
+	AssertEqual(c.String("idOfUser"), "ID of user", t)
+}
+
+func TestCaserSentenceToKebab(t *testing.T) {
+	c := Caser{From: SentenceCase, To: KebabCase}
+
+	AssertEqual(c.String("Get HTTP status"), "get-http-status", t)
+}
+
+func TestCaserRoundTrips(t *testing.T) {
+	AssertEqual(Caser{From: LowerSnakeCase, To: KebabCase}.RoundTrips("my_int_var"), true, t)
+	AssertEqual(Caser{From: KebabCase, To: LowerSnakeCase}.RoundTrips("my-int-var"), true, t)
+	AssertEqual(Caser{From: LowerCamelCase, To: LowerSnakeCase}.RoundTrips("userID"), true, t)
+}
+
+func TestCaserRoundTripsLossy(t *testing.T) {
+	AssertEqual(Caser{From: LowerSnakeCase, To: FlatCase}.RoundTrips("my_int_var"), false, t)
+	AssertEqual(Caser{From: FlatCase, To: LowerSnakeCase}.RoundTrips("myintvar"), true, t)
+	AssertEqual(Caser{From: LowerCamelCase, To: LowerSnakeCase}.RoundTrips("userId"), false, t)
+}
+
+func TestCaserRoundTripsDetected(t *testing.T) {
+	d, _ := Detect([]string{"my_var"})
+	AssertEqual(Caser{From: d, To: KebabCase}.RoundTrips("my_var"), false, t)
+}
+
+func TestCaserPreserveLeadingSeparator(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase, PreserveLeadingSeparator: true}
+	AssertEqual(c.String("_myVar"), "_my_var", t)
+
+	c = Caser{From: LowerSnakeCase, To: UpperCamelCase, PreserveLeadingSeparator: true}
+	AssertEqual(c.String("_private_method"), "_PrivateMethod", t)
+	AssertEqual(c.String("__init__"), "__Init", t)
+}
+
+func TestCaserPreserveTrailingSeparator(t *testing.T) {
+	c := Caser{
+		From:                      LowerSnakeCase,
+		To:                        UpperCamelCase,
+		PreserveLeadingSeparator:  true,
+		PreserveTrailingSeparator: true,
+	}
+
+	AssertEqual(c.String("__init__"), "__Init__", t)
+	AssertEqual(c.String("__"), "__", t)
+	AssertEqual(c.String("class_"), "Class_", t)
+}
+
+func TestCaserCamelToScreamingKebab(t *testing.T) {
+	for _, to := range []CaseConvention{ScreamingKebabCase, CobolCase} {
+		AssertEqual(Caser{From: LowerCamelCase, To: to}.String("httpStatusCode"), "HTTP-STATUS-CODE", t)
+		AssertEqual(Caser{From: LowerSnakeCase, To: to}.String("http_status_code"), "HTTP-STATUS-CODE", t)
+	}
+}
+
+func TestCaserScreamingKebabToCamel(t *testing.T) {
+	// Every word is uppercase, so only the split decides what the words
+	// are, and initialisms still come back as whole words.
+	AssertEqual(Caser{From: CobolCase, To: LowerCamelCase}.String("HTTP-STATUS-CODE"), "httpStatusCode", t)
+	AssertEqual(Caser{From: CobolCase, To: UpperCamelCase}.String("HTTP-STATUS-CODE"), "HTTPStatusCode", t)
+	AssertEqual(Caser{From: CobolCase, To: LowerCamelCase}.String("USER-ID"), "userID", t)
+}
+
+func TestCaserSnakeToDoubleColon(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: DoubleColonCase}
+
+	AssertEqual(c.String("my_namespace_path"), "My::Namespace::Path", t)
+	AssertEqual(c.String("app_http_controllers"), "App::HTTP::Controllers", t)
+}
+
+func TestDoubleColonSplit(t *testing.T) {
+	AssertEqual(DoubleColonCase.Split("My::Namespace::Path"), []string{"My", "Namespace", "Path"}, t)
+	// A single colon is not a separator.
+	AssertEqual(DoubleColonCase.Split("My::Odd:Name"), []string{"My", "Odd:Name"}, t)
+}
+
+func TestCaserDoubleColonToSnake(t *testing.T) {
+	c := Caser{From: DoubleColonCase, To: LowerSnakeCase}
+
+	AssertEqual(c.String("App::HTTP::Controllers"), "app_http_controllers", t)
+}
+
+func TestCaserCamelToPascalSnake(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: PascalSnakeCase}
+
+	AssertEqual(c.String("userHttpId"), "User_HTTP_ID", t)
+	AssertEqual(c.String("maxRetryCount"), "Max_Retry_Count", t)
+}
+
+func TestCaserCamelToCamelSnake(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: CamelSnakeCase}
+
+	// Like lowerCamelCase, the first word stays lowercase even if it is an
+	// initialism.
+	AssertEqual(c.String("userHttpId"), "user_HTTP_ID", t)
+	AssertEqual(c.String("idOfUser"), "id_Of_User", t)
+}
+
+func TestCaserMixedSnakeToSnake(t *testing.T) {
+	AssertEqual(Caser{From: PascalSnakeCase, To: LowerSnakeCase}.String("User_HTTP_ID"), "user_http_id", t)
+	AssertEqual(Caser{From: CamelSnakeCase, To: PascalSnakeCase}.String("user_Http_Id"), "User_HTTP_ID", t)
+}
+
+func TestNewCaser(t *testing.T) {
+	c, err := NewCaser(LowerSnakeCase, UpperCamelCase)
+	AssertEqual(err, nil, t)
+	AssertEqual(c.String("user_id"), "UserID", t)
+
+	_, err = NewCaser(CaseConvention{}, UpperCamelCase)
+	AssertEqual(err, ErrIncompleteConvention, t)
+
+	_, err = NewCaser(LowerSnakeCase, CaseConvention{JoinStyle: SimpleJoinStyle("_")})
+	AssertEqual(err, ErrIncompleteConvention, t)
+}
+
+func TestMustCaser(t *testing.T) {
+	c := MustCaser(LowerSnakeCase, KebabCase)
+	AssertEqual(c.String("user_id"), "user-id", t)
+}
+
+func TestMustCaserPanics(t *testing.T) {
+	defer func() {
+		AssertEqual(recover() != nil, true, t)
+	}()
+	MustCaser(LowerSnakeCase, CaseConvention{})
+}
+
+func TestCaserPreserveUnknownAllCaps(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: UpperCamelCase, PreserveUnknownAllCaps: true}
+
+	AssertEqual(c.String("numGPUCount"), "NumGPUCount", t)
+	AssertEqual(c.String("numHTTPCount"), "NumHTTPCount", t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: UpperCamelCase, PreserveUnknownAllCaps: true}.String("GPU_count"), "GPUCount", t)
+	// A single capital is not an acronym.
+	AssertEqual(c.String("userA"), "UserA", t)
+}
+
+func TestCaserPreserveUnknownAllCapsOff(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: UpperCamelCase}
+
+	AssertEqual(c.String("numGPUCount"), "NumGpuCount", t)
+	AssertEqual(c.String("numHTTPCount"), "NumHTTPCount", t)
+}
+
+func TestCaserPreserveUnknownAllCapsScreaming(t *testing.T) {
+	// All caps throughout gives no hints about acronyms.
+	c := Caser{From: ScreamingSnakeCase, To: LowerCamelCase, PreserveUnknownAllCaps: true}
+
+	AssertEqual(c.String("MAX_RETRIES"), "maxRetries", t)
+	AssertEqual(c.String("GPU_COUNT"), "gpuCount", t)
+	AssertEqual(c.String("USER_ID"), "userID", t)
+}
+
+func TestCaserPreserveUnknownAllCapsLowerTarget(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase, PreserveUnknownAllCaps: true}
+
+	AssertEqual(c.String("numGPUCount"), "num_gpu_count", t)
+}
+
+func TestCaserBytesMatchesString(t *testing.T) {
+	casers := []Caser{
+		{From: LowerSnakeCase, To: UpperCamelCase},
+		{From: LowerCamelCase, To: KebabCase},
+		{From: UpperCamelCase, To: HttpHeaderCase},
+		{From: LowerCamelCase, To: ScreamingSnakeCase},
+		{From: LowerCamelCase, To: LowerSnakeCase},
+		{From: LowerCamelCase, To: LowerSnakeCase, PreserveLeadingSeparator: true, PreserveTrailingSeparator: true},
+	}
+	inputs := []string{"", "_private_method", "__", "_userID_", "AsyncHTTPRequest", "my_int_var_20", "númeroDeTeléfono", "ǆemal_bey", "όνομαΧρήστη"}
+
+	for _, c := range casers {
+		for _, input := range inputs {
+			in := []byte(input)
+			specimen := c.Bytes(in)
+			AssertEqual(string(specimen), c.String(input), t)
+			AssertEqual(string(in), input, t)
+		}
+	}
+}
+
+func TestCaserBytesKeptComponents(t *testing.T) {
+	var kept []string
+	keeping := LowerSnakeCase
+	keeping.Join = func(components []string) string {
+		kept = components
+		return strings.Join(components, "_")
+	}
+	c := Caser{From: LowerSnakeCase, To: keeping}
+
+	in := []byte("hello_world")
+	AssertEqual(string(c.Bytes(in)), "hello_world", t)
+	copy(in, "HELLO_WORLD")
+	AssertEqual(kept, []string{"hello", "world"}, t)
+}
+
+// bytesResult keeps the results of the Bytes benchmarks, so that the compiler
+// can't avoid allocating them.
+var bytesResult []byte
+
+func BenchmarkCaserBytes(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	in := []byte("getHttpUrlParserForUserId")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		bytesResult = c.Bytes(in)
+	}
+}
+
+func BenchmarkCaserBytesGeneric(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: KebabCase}
+	in := []byte("getHttpUrlParserForUserId")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		bytesResult = c.Bytes(in)
+	}
+}
+
+func BenchmarkCaserString(b *testing.B) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	in := "getHttpUrlParserForUserId"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.String(in)
+	}
+}
+
+func TestCamelSplitTrailingCapital(t *testing.T) {
+	AssertEqual(camelJoinStyle.Split("userA"), []string{"user", "A"}, t)
+	AssertEqual(camelJoinStyle.Split("valueX"), []string{"value", "X"}, t)
+	AssertEqual(camelJoinStyle.Split("aB"), []string{"a", "B"}, t)
+	AssertEqual(camelJoinStyle.Split("X"), []string{"X"}, t)
+	AssertEqual(camelJoinStyle.Split("userAId"), []string{"user", "A", "Id"}, t)
+	AssertEqual(camelJoinStyle.Split("XMLHttpRequest"), []string{"XML", "Http", "Request"}, t)
+}
+
+func TestCaserTrailingCapital(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+
+	AssertEqual(c.String("userA"), "user_a", t)
+	AssertEqual(c.String("X"), "x", t)
+}
+
+func TestCaserLenientSplit(t *testing.T) {
+	for _, from := range []CaseConvention{LowerSnakeCase, KebabCase, LowerCamelCase} {
+		c := Caser{From: from, To: LowerSnakeCase, LenientSplit: true}
+		AssertEqual(c.String("my-var_name value"), "my_var_name_value", t)
+		AssertEqual(c.String("my-varName__value"), "my_var_name_value", t)
+	}
+
+	c := Caser{From: KebabCase, To: UpperCamelCase, LenientSplit: true}
+	AssertEqual(c.String(" user-http_Id "), "UserHTTPID", t)
+}
+
+func TestCaserStrictSplit(t *testing.T) {
+	c := Caser{From: KebabCase, To: LowerSnakeCase}
+	AssertEqual(c.String("my-var_name value"), "my_var_name value", t)
+}
+
+func TestCaserCamelToSlash(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: SlashLowerCase}
+
+	AssertEqual(c.String("userProfileImage"), "user/profile/image", t)
+	AssertEqual(c.String("userHTTPImageURL"), "user/http/image/url", t)
+}
+
+func TestCaserSlashToCamel(t *testing.T) {
+	c := Caser{From: SlashLowerCase, To: LowerCamelCase}
+
+	AssertEqual(c.String("api/v2/user/profile"), "apiV2UserProfile", t)
+	AssertEqual(c.String("/user//profile/"), "userProfile", t)
+}
+
+func TestCaserSlashPreserveSlashes(t *testing.T) {
+	c := Caser{
+		From:                      SlashLowerCase,
+		To:                        SlashLowerCase,
+		PreserveLeadingSeparator:  true,
+		PreserveTrailingSeparator: true,
+	}
+
+	AssertEqual(c.String("/User//Profile/"), "/user/profile/", t)
+	AssertEqual(Caser{From: SlashLowerCase, To: SlashLowerCase}.String("/User//Profile/"), "user/profile", t)
+}
+
+func TestCustomSplitJoinStyle(t *testing.T) {
+	// Keep "IPv4" together by splitting it off before the camel split.
+	split := func(s string) []string {
+		if strings.HasPrefix(s, "IPv4") {
+			return append([]string{"IPv4"}, camelJoinStyle.Split(s[len("IPv4"):])...)
+		}
+		return camelJoinStyle.Split(s)
+	}
+	convention := CaseConvention{
+		JoinStyle:      CustomSplitJoinStyle(camelJoinStyle.Join, split),
+		InitialCase:    ToTitleFirst,
+		SubsequentCase: ToTitleFirst,
+	}
+
+	AssertEqual(convention.SplitWords("IPv4Address"), []string{"IPv4", "Address"}, t)
+	AssertEqual(Caser{From: convention, To: LowerSnakeCase}.String("IPv4Address"), "ipv4_address", t)
+}
+
+func TestCaseConventionPostSplit(t *testing.T) {
+	convention := UpperCamelCase
+	convention.PostSplit = func(components []string) []string {
+		// Merge the "O", "Auth" that the camel split makes of "OAuth".
+		merged := []string{}
+		for i := 0; i < len(components); i++ {
+			if components[i] == "O" && i+1 < len(components) && components[i+1] == "Auth" {
+				merged = append(merged, "OAuth")
+				i++
+				continue
+			}
+			merged = append(merged, components[i])
+		}
+		return merged
+	}
+
+	AssertEqual(UpperCamelCase.SplitWords("OAuthToken"), []string{"O", "Auth", "Token"}, t)
+	AssertEqual(convention.SplitWords("OAuthToken"), []string{"OAuth", "Token"}, t)
+	AssertEqual(Caser{From: convention, To: KebabCase}.String("OAuthToken"), "oauth-token", t)
+}
+
+func TestToSmartTitle(t *testing.T) {
+	AssertEqual(ToSmartTitle("HTML"), "HTML", t)
+	AssertEqual(ToSmartTitle("Html"), "Html", t)
+	AssertEqual(ToSmartTitle("hello"), "Hello", t)
+	AssertEqual(ToSmartTitle("hELLO"), "Hello", t)
+	AssertEqual(ToSmartTitle("HELLOWORLD"), "Helloworld", t)
+	AssertEqual(ToSmartTitle("A"), "A", t)
+	AssertEqual(ToSmartTitle(""), "", t)
+}
+
+func TestCaserSmartTitle(t *testing.T) {
+	smart := CaseConvention{
+		JoinStyle:      UpperCamelCase.JoinStyle,
+		InitialCase:    ToSmartTitle,
+		SubsequentCase: ToSmartTitle,
+	}
+
+	AssertEqual(Caser{From: LowerCamelCase, To: smart}.String("asyncMVCRequest"), "AsyncMVCRequest", t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: smart}.String("max_retry_count"), "MaxRetryCount", t)
+}
+
+func TestCamelSplitPluralInitialisms(t *testing.T) {
+	AssertEqual(camelJoinStyle.Split("userIDs"), []string{"user", "IDs"}, t)
+	AssertEqual(camelJoinStyle.Split("listAPIs"), []string{"list", "APIs"}, t)
+	AssertEqual(camelJoinStyle.Split("parseURLsFast"), []string{"parse", "URLs", "Fast"}, t)
+	AssertEqual(camelJoinStyle.Split("IDsOfUsers"), []string{"IDs", "Of", "Users"}, t)
+	// Acronyms that aren't known initialisms have plurals too.
+	AssertEqual(camelJoinStyle.Split("MVCs"), []string{"MVCs"}, t)
+	AssertEqual(camelJoinStyle.Split("decodeJWTs"), []string{"decode", "JWTs"}, t)
+	// Not a plural: the "s" starts a word.
+	AssertEqual(camelJoinStyle.Split("HTTPServer"), []string{"HTTP", "Server"}, t)
+}
+
+func TestCamelSplitVersionedAcronyms(t *testing.T) {
+	AssertEqual(camelJoinStyle.Split("IPv4Address"), []string{"IPv4", "Address"}, t)
+	AssertEqual(camelJoinStyle.Split("getAPIv2Client"), []string{"get", "APIv2", "Client"}, t)
+	AssertEqual(camelJoinStyle.Split("apiV2"), []string{"api", "V2"}, t)
+	// Not a version: the "v" starts a word.
+	AssertEqual(camelJoinStyle.Split("IDvalue"), []string{"I", "Dvalue"}, t)
+}
+
+func TestCaserPluralInitialisms(t *testing.T) {
+	toCamel := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+	toSnake := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+
+	for _, tc := range []struct{ snake, camel string }{
+		{"user_ids", "userIDs"},
+		{"list_apis", "listAPIs"},
+		{"parse_urls", "parseURLs"},
+	} {
+		AssertEqual(toCamel.String(tc.snake), tc.camel, t)
+		AssertEqual(toSnake.String(tc.camel), tc.snake, t)
+	}
+
+	AssertEqual(Caser{From: ScreamingSnakeCase, To: UpperCamelCase}.String("ALL_IDS"), "AllIDs", t)
+	// A lone "s" is not the plural of anything.
+	AssertEqual(toCamel.String("user_s"), "userS", t)
+}
+
+func TestCaserThen(t *testing.T) {
+	normalize := Caser{From: KebabCase, To: LowerSnakeCase, LenientSplit: true}
+	c := normalize.Then(Caser{From: LowerSnakeCase, To: LowerCamelCase})
+
+	AssertEqual(c.String("my-var_name value"), "myVarNameValue", t)
+	AssertEqual(c.String("user-http_id"), "userHTTPID", t)
+}
+
+func TestCaserThenThen(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: KebabCase}.
+		Then(Caser{From: KebabCase, To: ScreamingSnakeCase}).
+		Then(Caser{From: ScreamingSnakeCase, To: DotLowerCase})
+
+	AssertEqual(c.String("someInitMethod"), "some.init.method", t)
+}
+
+func TestCaserLowercaseTargetKeepsNoInitialisms(t *testing.T) {
+	// A lowercase convention derived from one that keeps initialisms.
+	lower := UpperCamelCase
+	lower.InitialCase = strings.ToLower
+	lower.SubsequentCase = strings.ToLower
+
+	c := Caser{From: LowerCamelCase, To: lower}
+	AssertEqual(c.String("userHTTPId"), "userhttpid", t)
+	AssertEqual(Caser{From: KebabCase, To: lower}.String("json-api-url"), "jsonapiurl", t)
+	AssertEqual(Caser{From: LowerCamelCase, To: FlatCase}.String("userHTTPId"), "userhttpid", t)
+}
+
+func TestCaserSingleWordFastPath(t *testing.T) {
+	words := []string{"count", "name", "value", "id", "url", "http", "ids", "x", "uuid", "utf"}
+
+	for _, from := range predefinedConventions {
+		for _, to := range predefinedConventions {
+			c := Caser{From: from, To: to}
+			for _, word := range words {
+				if _, ok := c.plainWord(word); !ok {
+					t.Errorf("%q to %q: no fast path for %q", from.Example, to.Example, word)
+				}
+				slow := to.Join(c.caseWords(c.words(word)))
+				AssertEqual(c.String(word), slow, t)
+			}
+		}
+	}
+
+	custom := LowerSnakeCase
+	custom.JoinStyle = CustomSplitJoinStyle(func(components []string) string {
+		return "<" + strings.Join(components, "") + ">"
+	}, strings.Fields)
+	AssertEqual(Caser{From: LowerSnakeCase, To: custom}.String("count"), "<count>", t)
+
+	custom.JoinStyle = SimpleJoinStyle("o")
+	AssertEqual(Caser{From: custom, To: ScreamingSnakeCase}.String("count"), "C_UNT", t)
+
+	// Replacing a function of a predefined JoinStyle disables the fast path.
+	prefixed := LowerSnakeCase
+	prefixed.Join = func(components []string) string {
+		return "x_" + strings.Join(components, "_")
+	}
+	AssertEqual(Caser{From: LowerCamelCase, To: prefixed}.String("userName"), "x_user_name", t)
+	AssertEqual(Caser{From: LowerCamelCase, To: prefixed}.String("user"), "x_user", t)
+
+	letters := LowerSnakeCase
+	letters.Split = func(s string) []string {
+		return strings.Split(s, "e")
+	}
+	AssertEqual(Caser{From: letters, To: KebabCase}.String("user"), "us-r", t)
+}
+
+func TestCaserSingleWordAllocs(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+	allocs := testing.AllocsPerRun(100, func() {
+		c.String("count")
+	})
+	AssertEqual(allocs, 0.0, t)
+}
+
+func BenchmarkCaserSingleWord(b *testing.B) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.String("count")
+	}
+}
+
+func TestCaserWriteString(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase, PreserveLeadingSeparator: true}
+	names := []string{"user_id", "count", "_private_field", "http_url_parser", ""}
+
+	var sb, expected strings.Builder
+	for i, name := range names {
+		if i > 0 {
+			sb.WriteString(", ")
+			expected.WriteString(", ")
+		}
+		c.WriteString(&sb, name)
+		expected.WriteString(c.String(name))
+	}
+	AssertEqual(sb.String(), expected.String(), t)
+	AssertEqual(sb.String(), "UserID, Count, _PrivateField, HTTPURLParser, ", t)
+}
+
+func TestCaserWords(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	AssertEqual(c.Words("getHTTPStatus"), []string{"get", "HTTP", "Status"}, t)
+
+	c = Caser{From: LowerSnakeCase, To: LowerCamelCase, Digits: DigitsSeparate, PreserveLeadingSeparator: true}
+	AssertEqual(c.Words("_version2_api"), []string{"version", "2", "api"}, t)
+
+	words := c.Words("user_id")
+	words[0] = "changed"
+	AssertEqual(c.Words("user_id"), []string{"user", "id"}, t)
+}
+
+func TestCaserMidWordInitialismAnyCase(t *testing.T) {
+	fromCamel := Caser{From: LowerCamelCase, To: LowerCamelCase}
+	AssertEqual(fromCamel.String("getJsonData"), "getJSONData", t)
+	AssertEqual(fromCamel.String("getJSONData"), "getJSONData", t)
+	AssertEqual(fromCamel.String("sendHttpRequest"), "sendHTTPRequest", t)
+	AssertEqual(fromCamel.String("sendHTTPRequest"), "sendHTTPRequest", t)
+
+	fromSnake := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+	for _, json := range []string{"Json", "json", "JSON", "jSoN"} {
+		AssertEqual(fromSnake.String("get_"+json+"_data"), "GetJSONData", t)
+	}
+	for _, http := range []string{"Http", "http", "HTTP"} {
+		AssertEqual(fromSnake.String("send_"+http+"_request"), "SendHTTPRequest", t)
+	}
+}
+
+func TestRegisterInitialism(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerCamelCase}
+	AssertEqual(c.String("productSku"), "productSku", t)
+
+	RegisterInitialism("sku")
+	defer ResetInitialisms()
+	AssertEqual(c.String("productSku"), "productSKU", t)
+	AssertEqual(c.String("productSkus"), "productSKUs", t)
+	AssertEqual(c.String("userId"), "userID", t)
+
+	ResetInitialisms()
+	AssertEqual(c.String("productSku"), "productSku", t)
+	AssertEqual(c.String("userId"), "userID", t)
+}
+
+func TestCaserEmptyAndSeparatorOnlyInput(t *testing.T) {
+	for _, from := range predefinedConventions {
+		for _, to := range predefinedConventions {
+			c := Caser{From: from, To: to}
+			for _, in := range []string{"", " ", "   ", "_", "-", "\t\n"} {
+				if out := c.String(in); out != "" {
+					t.Errorf("%q to %q: %q converted to %q", from.Example, to.Example, in, out)
+				}
+			}
+		}
+	}
+
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase, PreserveLeadingSeparator: true}
+	AssertEqual(c.String("__"), "__", t)
+	AssertEqual(len(c.Words("  ")), 0, t)
+}
+
+func TestSplitFunctions(t *testing.T) {
+	AssertEqual(SplitCamel("getHTTPStatus"), []string{"get", "HTTP", "Status"}, t)
+	AssertEqual(SplitSnake("user_id_2"), []string{"user", "id", "2"}, t)
+	AssertEqual(SplitKebab("max-retry-count"), []string{"max", "retry", "count"}, t)
+
+	for _, s := range []string{"", "userIDs", "HTML5Parser", "a_b-c", "__x--y__", "MyXMLHttpRequest"} {
+		AssertEqual(SplitCamel(s), LowerCamelCase.Split(s), t)
+		AssertEqual(SplitCamel(s), UpperCamelCase.Split(s), t)
+		AssertEqual(SplitSnake(s), LowerSnakeCase.Split(s), t)
+		AssertEqual(SplitSnake(s), ScreamingSnakeCase.Split(s), t)
+		AssertEqual(SplitKebab(s), KebabCase.Split(s), t)
+		AssertEqual(SplitKebab(s), HttpHeaderCase.Split(s), t)
+	}
+}
+
+func FuzzCaserString(f *testing.F) {
+	for _, seed := range []string{
+		"", " ", "_", "A", "aB", "HTTPServer", "userIDs", "HTML5Parser",
+		"__x--y__", "ǅungla", "İstanbul", "ΣΑΣ", "ﬀ_test", "ét́é",
+		"日本語_テキスト", "a\x00b", "x​Y", "\xff\xfeA",
+		strings.Repeat("ID", 500), strings.Repeat("aB1_", 250),
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		for _, from := range predefinedConventions {
+			for _, to := range predefinedConventions {
+				out := Caser{From: from, To: to}.String(s)
+				if utf8.ValidString(s) && !utf8.ValidString(out) {
+					t.Errorf("%q to %q: %q converted to invalid UTF-8 %q", from.Example, to.Example, s, out)
+				}
+			}
+		}
+	})
+}
+
+func TestCaserWithAcronymMap(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}.WithoutInitialisms("id")
+	AssertEqual(c.String("user_id"), "userId", t)
+
+	c = c.WithAcronymMap(map[string]string{"id": "ID", "IOS": "iOS", "url": "Url"})
+	AssertEqual(c.String("user_id"), "userID", t)
+	AssertEqual(c.String("id_value"), "idValue", t)
+	AssertEqual(c.String("user_ios_version"), "useriOSVersion", t)
+	AssertEqual(c.String("base_url"), "baseUrl", t)
+	AssertEqual(c.String("http_url"), "httpUrl", t)
+
+	upper := Caser{From: LowerSnakeCase, To: UpperCamelCase}.
+		WithAcronymMap(map[string]string{"ios": "iOS", "sku": "SKU"})
+	AssertEqual(upper.String("ios_app_sku"), "iOSAppSKU", t)
+	AssertEqual(upper.String("sku"), "SKU", t)
+
+	snake := Caser{From: LowerCamelCase, To: LowerSnakeCase}.WithAcronymMap(map[string]string{"id": "ID"})
+	AssertEqual(snake.String("userID"), "user_id", t)
+}
+
+func TestCaserStringQualified(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	AssertEqual(c.StringQualified("subPkg.myVar", "."), "sub_pkg.my_var", t)
+	AssertEqual(c.StringQualified("pkg.subPkg.myVar", "."), "pkg.sub_pkg.my_var", t)
+	AssertEqual(c.StringQualified("myVar", "."), "my_var", t)
+
+	toCamel := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+	AssertEqual(toCamel.StringQualified("my_mod::http_client::get_url", "::"), "MyMod::HTTPClient::GetURL", t)
+	AssertEqual(toCamel.StringQualified("my_var", ""), "MyVar", t)
+}
+
+func TestCaserStringIfNeeded(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+
+	result, changed := c.StringIfNeeded("user_id")
+	AssertEqual(result, "userID", t)
+	AssertEqual(changed, true, t)
+
+	// String would read "userID" as a single snake_case word.
+	AssertEqual(c.String("userID"), "userid", t)
+	result, changed = c.StringIfNeeded("userID")
+	AssertEqual(result, "userID", t)
+	AssertEqual(changed, false, t)
+
+	result, changed = c.StringIfNeeded("count")
+	AssertEqual(result, "count", t)
+	AssertEqual(changed, false, t)
+
+	toSnake := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	result, changed = toSnake.StringIfNeeded("max_retry_count")
+	AssertEqual(result, "max_retry_count", t)
+	AssertEqual(changed, false, t)
+
+	result, changed = toSnake.StringIfNeeded("maxRetryCount")
+	AssertEqual(result, "max_retry_count", t)
+	AssertEqual(changed, true, t)
+
+	toKebab := Caser{From: LowerSnakeCase, To: KebabCase}
+	result, changed = toKebab.StringIfNeeded("max_retry")
+	AssertEqual(result, "max-retry", t)
+	AssertEqual(changed, true, t)
+}
+
+func TestCaserAdaCase(t *testing.T) {
+	toAda := Caser{From: LowerCamelCase, To: AdaCase}
+	AssertEqual(toAda.String("getHttpStatus"), "Get_HTTP_Status", t)
+	AssertEqual(toAda.String("parseJSONForUserID"), "Parse_JSON_For_User_ID", t)
+	AssertEqual(toAda.String("maxValue"), "Max_Value", t)
+
+	fromAda := Caser{From: AdaCase, To: LowerCamelCase}
+	AssertEqual(fromAda.String("Get_HTTP_Status"), "getHTTPStatus", t)
+	AssertEqual(fromAda.String("Get_Http_Status"), "getHTTPStatus", t)
+	AssertEqual(fromAda.RoundTrips("Get_HTTP_Status"), true, t)
+	AssertEqual(Caser{From: AdaCase, To: KebabCase}.RoundTrips("Get_HTTP_Status"), true, t)
+}
+
+func TestCaserMinAcronymLength(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerCamelCase}
+	AssertEqual(c.String("userId"), "userID", t)
+
+	c.MinAcronymLength = 3
+	AssertEqual(c.String("userId"), "userId", t)
+	AssertEqual(c.String("userUrl"), "userURL", t)
+	AssertEqual(c.String("userIds"), "userIds", t)
+	AssertEqual(c.String("userUrls"), "userURLs", t)
+	AssertEqual(c.String("useTls"), "useTLS", t)
+	AssertEqual(c.WithAcronymMap(map[string]string{"id": "ID"}).String("userId"), "userID", t)
+
+	keepCaps := Caser{From: LowerSnakeCase, To: UpperCamelCase, PreserveUnknownAllCaps: true, MinAcronymLength: 3}
+	AssertEqual(keepCaps.String("DB_name_GPU"), "DbNameGPU", t)
+}
+
+func TestCaserMapping(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	AssertEqual(c.Mapping("userID", "createdAt", "name", "userID"), map[string]string{
+		"userID":    "user_id",
+		"createdAt": "created_at",
+		"name":      "name",
+	}, t)
+
+	AssertEqual(c.Mapping(), map[string]string{}, t)
+	AssertEqual(c.Mapping() != nil, true, t)
+	AssertEqual(c.Mapping([]string(nil)...) != nil, true, t)
+}
+
+func TestCaserInitialismPrefixRecursLater(t *testing.T) {
+	// The initialism at the start also occurs later in the name, both as
+	// a word and inside one. Only whole words may be uppercased.
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+	AssertEqual(c.String("id_idle_id"), "IDIdleID", t)
+	AssertEqual(c.String("url_urlencoded"), "URLUrlencoded", t)
+	AssertEqual(Caser{From: LowerCamelCase, To: UpperCamelCase}.String("idIdleId"), "IDIdleID", t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: LowerCamelCase}.String("ids_idle_ids"), "idsIdleIDs", t)
+}
+
+func TestCaserPreserveSourceCaps(t *testing.T) {
+	c := Caser{From: KebabCase, To: LowerCamelCase}
+	AssertEqual(c.String("get-OAuth-token"), "getOauthToken", t)
+	AssertEqual(c.String("use-IPv4-address"), "useIPv4Address", t)
+	AssertEqual(c.String("new-gRPC-client"), "newGrpcClient", t)
+
+	c.PreserveSourceCaps = true
+	AssertEqual(c.String("get-OAuth-token"), "getOAuthToken", t)
+	AssertEqual(c.String("use-IPv4-address"), "useIPv4Address", t)
+	AssertEqual(c.String("new-gRPC-client"), "newGRPCClient", t)
+	AssertEqual(c.String("OAuth-token"), "oAuthToken", t)
+	AssertEqual(c.String("GRPC-client"), "gRPCClient", t)
+	AssertEqual(c.String("user-id"), "userID", t)
+	AssertEqual(c.String("GET-OAUTH-TOKEN"), "getOauthToken", t)
+
+	camel := Caser{From: UpperCamelCase, To: UpperCamelCase, PreserveSourceCaps: true}
+	AssertEqual(camel.String("NewGRPCClient"), "NewGRPCClient", t)
+	AssertEqual(camel.String("AsyncMVCRequest"), "AsyncMVCRequest", t)
+	AssertEqual(camel.String("ParseOAuthToken"), "ParseOAuthToken", t)
+
+	snake := Caser{From: KebabCase, To: LowerSnakeCase, PreserveSourceCaps: true}
+	AssertEqual(snake.String("get-OAuth-token"), "get_oauth_token", t)
+}
+
+func TestCaserMultibyteWordBeforeInitialism(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+	AssertEqual(c.String("größe_id"), "GrößeID", t)
+	AssertEqual(c.String("straße_url"), "StraßeURL", t)
+	AssertEqual(Caser{From: LowerCamelCase, To: LowerCamelCase}.String("größeId"), "größeID", t)
+
+	// Lowercasing "İ" takes fewer bytes than it does, which used to shift
+	// the position at which the initialism was looked for.
+	AssertEqual(Caser{From: LowerSnakeCase, To: LowerCamelCase}.String("İZMİR_url"), "izmirURL", t)
+}
+
+func TestCaserWordMap(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCaseKeepCaps}
+	c.WordMap = func(i int, word string) string {
+		if i == 0 {
+			return strings.ToUpper(word)
+		}
+		return word
+	}
+	AssertEqual(c.String("user_name"), "USERName", t)
+
+	stopwords := Caser{From: LowerCamelCase, To: KebabCase}
+	stopwords.WordMap = func(i int, word string) string {
+		if strings.EqualFold(word, "the") {
+			return ""
+		}
+		return word
+	}
+	AssertEqual(stopwords.String("getTheUserName"), "get-user-name", t)
+	AssertEqual(stopwords.String("the"), "", t)
+
+	var sb strings.Builder
+	stopwords.WriteString(&sb, "theEnd")
+	AssertEqual(sb.String(), "end", t)
+}
+
+func TestCamelSplitSeparators(t *testing.T) {
+	AssertEqual(camelJoinStyle.Split("FOO_BAR"), []string{"FOO_BAR"}, t)
+	AssertEqual(camelJoinStyle.Split("foo_Bar"), []string{"foo_", "Bar"}, t)
+	AssertEqual(Caser{From: UpperCamelCase, To: KebabCase, LenientSplit: true}.String("FOO_BAR"), "foo-bar", t)
+}
+
+func TestCaserValidate(t *testing.T) {
+	AssertEqual(Caser{From: LowerCamelCase, To: LowerSnakeCase}.Validate(), nil, t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: LowerCamelCase}.Validate(), nil, t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: HttpHeaderCase}.Validate(), nil, t)
+
+	for _, to := range []CaseConvention{FlatCase, UpperFlatCase} {
+		err := Caser{From: LowerCamelCase, To: to}.Validate()
+		AssertEqual(errors.Is(err, ErrLossyConvention), true, t)
+		AssertEqual(strings.Contains(err.Error(), to.Example), true, t)
+	}
+
+	AssertEqual(Caser{To: LowerSnakeCase}.Validate(), ErrIncompleteConvention, t)
+	AssertEqual(Caser{From: LowerSnakeCase}.Validate(), ErrIncompleteConvention, t)
+}
+
+func TestCamelSplitAcronymBeforeWord(t *testing.T) {
+	AssertEqual(SplitCamel("XMLHttpRequest"), []string{"XML", "Http", "Request"}, t)
+	AssertEqual(SplitCamel("innerHTML"), []string{"inner", "HTML"}, t)
+	AssertEqual(SplitCamel("parseJSONData"), []string{"parse", "JSON", "Data"}, t)
+
+	toSnake := Caser{From: UpperCamelCase, To: LowerSnakeCase}
+	AssertEqual(toSnake.String("XMLHttpRequest"), "xml_http_request", t)
+	AssertEqual(Caser{From: LowerCamelCase, To: KebabCase}.String("innerHTML"), "inner-html", t)
+	AssertEqual(Caser{From: LowerCamelCase, To: KebabCase}.String("parseJSONData"), "parse-json-data", t)
+
+	// HTTP is a known initialism, so it is uppercased on the way back,
+	// unless the caller opts out of it.
+	AssertEqual(Caser{From: LowerSnakeCase, To: UpperCamelCase}.String("xml_http_request"), "XMLHTTPRequest", t)
+	AssertEqual(toSnake.WithoutInitialisms("http").RoundTrips("XMLHttpRequest"), true, t)
+	AssertEqual(Caser{From: LowerCamelCase, To: LowerSnakeCase}.RoundTrips("innerHTML"), true, t)
+	AssertEqual(Caser{From: LowerCamelCase, To: LowerSnakeCase}.RoundTrips("parseJSONData"), true, t)
+}
+
+func TestCaseConventionWithWordCase(t *testing.T) {
+	titleThenLower := UpperCamelCase.WithWordCase(ToTitleFirst, strings.ToLower)
+	AssertEqual(titleThenLower.Example, UpperCamelCase.Example, t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: titleThenLower}.String("user_name_value"), "Usernamevalue", t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: titleThenLower}.String("user_http_id"), "UserHTTPID", t)
+
+	acronymFree := UpperCamelCase.WithWordCase(ToTitleFirst, ToStrictTitle)
+	acronymFree.KeepInitialisms = false
+	AssertEqual(Caser{From: LowerSnakeCase, To: acronymFree}.String("user_http_id"), "UserHttpId", t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: UpperCamelCase}.String("user_http_id"), "UserHTTPID", t)
+}
+
+func TestCaseConventionWithExample(t *testing.T) {
+	AssertEqual(TrainCase.Example, "Train-Case", t)
+	AssertEqual(UpperKebabCase.Example, "Upper-Kebab-Case", t)
+	AssertEqual(TrainCase.Name(), "train", t)
+	AssertEqual(Caser{From: LowerCamelCase, To: TrainCase}.String("userID"), "User-Id", t)
+	AssertIdentical(TrainCase.Join, UpperKebabCase.Join, t)
+	AssertIdentical(TrainCase.InitialCase, UpperKebabCase.InitialCase, t)
+
+	AssertEqual(AdaCase.Name(), "ada", t)
+	AssertEqual(AdaCase.KeepInitialisms, true, t)
+	AssertEqual(Caser{From: LowerCamelCase, To: AdaCase}.String("httpRequest"), "HTTP_Request", t)
+
+	AssertEqual(CobolCase.Name(), "cobol", t)
+	AssertEqual(Caser{From: LowerCamelCase, To: CobolCase}.String("userID"), Caser{From: LowerCamelCase, To: ScreamingKebabCase}.String("userID"), t)
+}
+
+func TestCaserLowercaseShortWords(t *testing.T) {
+	stopwords := []string{"a", "an", "of", "the", "in"}
+	c := Caser{From: LowerCamelCase, To: TitleSpaceCase, LowercaseShortWords: stopwords}
+	AssertEqual(c.String("numberOfItems"), "Number of Items", t)
+	AssertEqual(c.String("aValue"), "A Value", t)
+	AssertEqual(c.String("theEndOfTheRoad"), "The End of the Road", t)
+	AssertEqual(c.String("catInTheHat"), "Cat in the Hat", t)
+	AssertEqual(c.String("inventory"), "Inventory", t)
+
+	c.To = TrainCase
+	AssertEqual(c.String("numberOfItems"), "Number-of-Items", t)
+
+	// Targets that don't capitalize or separate words are unaffected.
+	c.To = LowerSnakeCase
+	AssertEqual(c.String("numberOfItems"), "number_of_items", t)
+	c.To = ScreamingSnakeCase
+	AssertEqual(c.String("numberOfItems"), "NUMBER_OF_ITEMS", t)
+	c.To = UpperCamelCase
+	AssertEqual(c.String("numberOfItems"), "NumberOfItems", t)
+
+	c = Caser{From: LowerCamelCase, To: TitleSpaceCase}
+	AssertEqual(c.String("numberOfItems"), "Number Of Items", t)
+}
+
+func TestCaserStringErr(t *testing.T) {
+	c := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+
+	result, err := c.StringErr("someInitMethod")
+	AssertEqual(result, "some_init_method", t)
+	AssertEqual(err, nil, t)
+
+	result, err = c.StringErr("")
+	AssertEqual(result, "", t)
+	AssertEqual(err, nil, t)
+
+	result, err = c.StringErr("some\xffMethod")
+	AssertEqual(result, "", t)
+	AssertEqual(errors.Is(err, ErrInvalidUTF8), true, t)
+
+	// String stays lenient.
+	AssertEqual(c.String("some\xffMethod") != "", true, t)
+}
+
+func TestCaserPowerShellCase(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: PowerShellCase}
+
+	AssertEqual(c.String("dns_server"), "DNSServer", t)
+	AssertEqual(c.String("computer_name"), "ComputerName", t)
+	AssertEqual(c.String("ip_address"), "IPAddress", t)
+	AssertEqual(c.String("process_id"), "ProcessId", t)
+	AssertEqual(c.String("base_uri"), "BaseURI", t)
+	AssertEqual(c.String("ip"), "IP", t)
+
+	back := Caser{From: PowerShellCase, To: LowerSnakeCase}
+	AssertEqual(back.String("DNSServer"), "dns_server", t)
+	AssertEqual(back.String("ComputerName"), "computer_name", t)
+}
+
+func TestCaseConventionName(t *testing.T) {
+	AssertEqual(LowerCamelCase.Name(), "lower_camel", t)
+	AssertEqual(UpperCamelCase.Name(), "upper_camel", t)
+	AssertEqual(KebabCase.Name(), "kebab", t)
+	AssertEqual(ScreamingSnakeCase.Name(), "screaming_snake", t)
+	AssertEqual(HttpHeaderCase.Name(), "http_header", t)
+	AssertEqual(TitleSpaceCase.Name(), "title_space", t)
+	AssertEqual(SentenceCase.Name(), "sentence", t)
+	AssertEqual(FlatCase.Name(), "flat", t)
+	AssertEqual(UpperCamelCaseKeepCaps.Name(), "upper_camel_keep_caps", t)
+	AssertEqual(LowerCamelCaseKeepCaps.Name(), "lower_camel_keep_caps", t)
+	AssertEqual(CaseConvention{}.Name(), "", t)
+
+	// Every predefined convention has a name of its own, by which it can be
+	// looked up.
+	seen := map[string]string{}
+	for _, convention := range predefinedConventions {
+		name := convention.Name()
+		if other, ok := seen[name]; ok {
+			t.Errorf("%q and %q are both named %q", other, convention.Example, name)
+		}
+		seen[name] = convention.Example
+		found, ok := ConventionByName(name)
+		AssertEqual(ok, true, t)
+		AssertEqual(found.Example, convention.Example, t)
+	}
+}
+
+func TestCaserDescribe(t *testing.T) {
+	AssertEqual(Caser{From: LowerCamelCase, To: KebabCase}.Describe(), "Caser(lower_camel → kebab)", t)
+	AssertEqual(Caser{From: LowerSnakeCase, To: DotScreamingCase}.Describe(), "Caser(lower_snake → dot_screaming)", t)
+	AssertEqual(Caser{From: LowerCamelCase, To: UpperCamelCaseKeepCaps}.Describe(), "Caser(lower_camel → upper_camel_keep_caps)", t)
+	AssertEqual(Caser{From: Detected{}, To: CaseConvention{}}.Describe(), "Caser(custom → custom)", t)
+}
+
+func TestCaserCaseOnlySplit(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerSnakeCase, CaseOnlySplit: true}
+	AssertEqual(c.Words("foo_barBaz"), []string{"foo_bar", "Baz"}, t)
+	AssertEqual(c.Words("my-varName"), []string{"my-var", "Name"}, t)
+	AssertEqual(c.Words("HTTPServer_v2"), []string{"HTTP", "Server_v2"}, t)
+	AssertEqual(c.String("foo_barBaz"), "foo_bar_baz", t)
+
+	// It overrides LenientSplit, which splits on separators too.
+	c.LenientSplit = true
+	AssertEqual(c.Words("foo_barBaz"), []string{"foo_bar", "Baz"}, t)
+	c.CaseOnlySplit = false
+	AssertEqual(c.Words("foo_barBaz"), []string{"foo", "bar", "Baz"}, t)
+}
+
+func TestCaserAcronymSuffixes(t *testing.T) {
+	toSnake := Caser{From: LowerCamelCase, To: LowerSnakeCase}
+	toCamel := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+	toPascal := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+
+	AssertEqual(toSnake.String("parseURLs"), "parse_urls", t)
+	AssertEqual(toCamel.String("parse_urls"), "parseURLs", t)
+	AssertEqual(toSnake.String("decodeJWTs"), "decode_jwts", t)
+	AssertEqual(toCamel.WithInitialisms([]string{"JWT"}).String("decode_jwts"), "decodeJWTs", t)
+
+	AssertEqual(toSnake.String("apiV2"), "api_v2", t)
+	AssertEqual(toCamel.String("api_v2"), "apiV2", t)
+	AssertEqual(toPascal.String("api_v2"), "APIV2", t)
+
+	AssertEqual(toSnake.String("getAPIv2Client"), "get_apiv2_client", t)
+	AssertEqual(toCamel.String("get_apiv2_client"), "getAPIv2Client", t)
+	AssertEqual(toPascal.String("ipv4_address"), "IPv4Address", t)
+	AssertEqual(Caser{From: UpperCamelCase, To: KebabCase}.String("IPv4Address"), "ipv4-address", t)
+	// Only initialisms keep their version tags apart.
+	AssertEqual(toPascal.String("tv2_show"), "Tv2Show", t)
+}
+
+func TestAffixJoinStyle(t *testing.T) {
+	angled := CaseConvention{
+		JoinStyle:      AffixJoinStyle("<", "_", ">"),
+		InitialCase:    ToTitleFirst,
+		SubsequentCase: ToTitleFirst,
+		Example:        "<Angled_Pascal_Snake>",
+	}
+	AssertEqual(angled.Join([]string{"Foo", "Bar"}), "<Foo_Bar>", t)
+	AssertEqual(angled.Split("<Foo_Bar>"), []string{"Foo", "Bar"}, t)
+	AssertEqual(angled.Split("Foo_Bar"), []string{"Foo", "Bar"}, t)
+
+	to := Caser{From: LowerCamelCase, To: angled}
+	from := Caser{From: angled, To: LowerCamelCase}
+	AssertEqual(to.String("fooBar"), "<Foo_Bar>", t)
+	AssertEqual(from.String("<Foo_Bar>"), "fooBar", t)
+	AssertEqual(to.RoundTrips("fooBarBaz"), true, t)
+	AssertEqual(from.RoundTrips("<Foo_Bar_Baz>"), true, t)
+
+	at := CaseConvention{
+		JoinStyle:      AffixJoinStyle("@", "-", "@"),
+		InitialCase:    strings.ToLower,
+		SubsequentCase: strings.ToLower,
+	}
+	AssertEqual(Caser{From: LowerSnakeCase, To: at}.String("foo_bar"), "@foo-bar@", t)
+	AssertEqual(Caser{From: at, To: LowerSnakeCase}.String("@foo-bar@"), "foo_bar", t)
+}
+
+func TestCaserNormalizeUnicode(t *testing.T) {
+	composed := "été_value"     // "été_value" with precomposed accents
+	decomposed := "été_value" // "été_value" with combining accents
+
+	c := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+	AssertEqual(c.String(composed), "ÉtéValue", t)
+	AssertEqual(c.String(decomposed), "ÉtéValue", t)
+
+	c.NormalizeUnicode = true
+	AssertEqual(c.String(composed), "ÉtéValue", t)
+	AssertEqual(c.String(decomposed), "ÉtéValue", t)
+
+	toSnake := Caser{From: LowerCamelCase, To: LowerSnakeCase, NormalizeUnicode: true}
+	AssertEqual(toSnake.String("caféAu"), "café_au", t)
+	AssertEqual(toSnake.String("caféAu"), "café_au", t)
+}
+
+func TestCaserCanonical(t *testing.T) {
+	for _, from := range []CaseConvention{LowerCamelCase, LowerSnakeCase, ScreamingSnakeCase} {
+		c := Caser{From: from, To: from}
+		AssertEqual(c.Canonical("userID"), "userid", t)
+		AssertEqual(c.Canonical("user_id"), "userid", t)
+		AssertEqual(c.Canonical("USER_ID"), "userid", t)
+		AssertEqual(c.Canonical("user_idx"), "useridx", t)
+		AssertEqual(c.Canonical(""), "", t)
+	}
+
+	c := Caser{From: LowerCamelCase, To: LowerCamelCase, PreserveLeadingSeparator: true}
+	AssertEqual(c.Canonical("_ÉtéValue"), "étévalue", t)
+
+	c.WordMap = func(i int, word string) string {
+		return strings.TrimSuffix(word, "s")
+	}
+	AssertEqual(c.Canonical("userIDs"), c.Canonical("userID"), t)
+}
+
+func TestCaserAdjacentInitialisms(t *testing.T) {
+	toLower := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+	toUpper := Caser{From: LowerSnakeCase, To: UpperCamelCase}
+
+	AssertEqual(toLower.String("http_url_parser"), "httpURLParser", t)
+	AssertEqual(toUpper.String("http_url_parser"), "HTTPURLParser", t)
+	AssertEqual(toLower.String("io_rpc_handler"), "ioRPCHandler", t)
+	AssertEqual(toUpper.String("io_rpc_handler"), "IoRPCHandler", t)
+	AssertEqual(toUpper.WithInitialisms([]string{"IO", "RPC"}).String("io_rpc_handler"), "IORPCHandler", t)
+	AssertEqual(toUpper.String("api_id_list"), "APIIDList", t)
+
+	// In lowerCamelCase, the first initialism is lowercase, so the words
+	// can be found again.
+	AssertEqual(toLower.RoundTrips("http_url_parser"), true, t)
+	AssertEqual(Caser{From: LowerCamelCase, To: LowerSnakeCase}.String("httpURLParser"), "http_url_parser", t)
+	// Back-to-back initialisms in uppercase are split like one.
+	AssertEqual(Caser{From: UpperCamelCase, To: LowerSnakeCase}.String("HTTPURLParser"), "httpurl_parser", t)
+}
+
+func TestCaserKebabToTitles(t *testing.T) {
+	toTitle := Caser{From: KebabCase, To: TitleSpaceCase}
+	toTrain := Caser{From: KebabCase, To: TrainCase}
+
+	AssertEqual(toTitle.String("well-known-config"), "Well Known Config", t)
+	AssertEqual(toTrain.String("well-known-config"), "Well-Known-Config", t)
+	AssertEqual(Caser{From: KebabCase, To: SentenceCase}.String("well-known-config"), "Well known config", t)
+
+	// Every segment is a word of its own, however it is cased.
+	AssertEqual(toTitle.String("well-known-http-path"), "Well Known HTTP Path", t)
+	AssertEqual(toTrain.String("well-known-http-path"), "Well-Known-Http-Path", t)
+	AssertEqual(toTitle.String("well--known"), "Well Known", t)
+
+	AssertEqual(toTitle.RoundTrips("well-known-config"), true, t)
+	AssertEqual(toTrain.RoundTrips("well-known-config"), true, t)
+	AssertEqual(Caser{From: TitleSpaceCase, To: KebabCase}.String("Well Known Config"), "well-known-config", t)
+}
@@ -0,0 +1,65 @@
+package varcaser
+
+// This file defines the conversion of the keys of YAML documents.
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TransformYAMLKeys converts every string mapping key in the YAML documents in
+// data with c, including the keys of mappings nested in other mappings or in
+// sequences. The documents are decoded into nodes and encoded again, so key
+// order, comments, anchors and aliases survive, while values, including the
+// keys that aliases and merges refer to, are left unchanged. Indentation is
+// normalized to two spaces.
+func (c Caser) TransformYAMLKeys(in []byte) ([]byte, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(in))
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	for {
+		var document yaml.Node
+		if err := decoder.Decode(&document); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		c.transformYAMLNode(&document)
+		if err := encoder.Encode(&document); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// transformYAMLNode converts the mapping keys in a decoded YAML node. Aliases
+// are not followed, since the nodes they refer to are converted where they
+// are anchored.
+func (c Caser) transformYAMLNode(node *yaml.Node) {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			switch {
+			case key.Kind == yaml.ScalarNode && key.ShortTag() == "!!str":
+				key.Value = c.String(key.Value)
+			case key.ShortTag() == "!!merge":
+				// Without this, yaml.v3 encodes merge keys
+				// with an explicit tag, as "!!merge <<".
+				key.Tag = ""
+			}
+		}
+	}
+	if node.Kind != yaml.AliasNode {
+		for _, child := range node.Content {
+			c.transformYAMLNode(child)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package varcaser
+
+import "testing"
+
+func TestTransformYAMLKeysNested(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+
+	specimen, err := c.TransformYAMLKeys([]byte(`server:
+  listen_addr: 0.0.0.0
+  max_conns: 100
+  tls_config:
+    cert_file: server_cert.pem
+user_id: 42
+`))
+	AssertEqual(err, nil, t)
+	AssertEqual(string(specimen), `server:
+  listenAddr: 0.0.0.0
+  maxConns: 100
+  tlsConfig:
+    certFile: server_cert.pem
+userID: 42
+`, t)
+}
+
+func TestTransformYAMLKeysSequence(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: KebabCase}
+
+	specimen, err := c.TransformYAMLKeys([]byte(`backends:
+  - host_name: a
+    weight_factor: 1
+  - host_name: b
+    tags: [first_tag, second_tag]
+`))
+	AssertEqual(err, nil, t)
+	AssertEqual(string(specimen), `backends:
+  - host-name: a
+    weight-factor: 1
+  - host-name: b
+    tags: [first_tag, second_tag]
+`, t)
+}
+
+func TestTransformYAMLKeysComments(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+
+	specimen, err := c.TransformYAMLKeys([]byte(`# Service settings.
+retry_count: 3 # per request
+defaults: &default_limits
+  max_size: 10
+limits:
+  <<: *default_limits
+  min_size: 1
+`))
+	AssertEqual(err, nil, t)
+	AssertEqual(string(specimen), `# Service settings.
+retryCount: 3 # per request
+defaults: &default_limits
+  maxSize: 10
+limits:
+  <<: *default_limits
+  minSize: 1
+`, t)
+}
+
+func TestTransformYAMLKeysInvalid(t *testing.T) {
+	c := Caser{From: LowerSnakeCase, To: LowerCamelCase}
+
+	_, err := c.TransformYAMLKeys([]byte("key: [unclosed"))
+	AssertEqual(err != nil, true, t)
+}